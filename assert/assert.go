@@ -1,13 +1,20 @@
 package assert
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"os"
 	"reflect"
 	"runtime"
+	"runtime/debug"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 	"unicode"
@@ -30,6 +37,16 @@ const (
 
 	// EMPTY is a constant for the empty (0 length) string.
 	EMPTY = ""
+
+	// FileContainsMaxBytes is the maximum number of bytes `FileContains` will read from a file.
+	FileContainsMaxBytes = 10 << 20
+)
+
+const (
+	// fileContentsChunkSize is the number of bytes `FileContents` reads from a file at a time.
+	fileContentsChunkSize = 32 << 10
+	// fileContentsSnippetSize is the number of bytes shown from each side of a `FileContents` difference.
+	fileContentsSnippetSize = 32
 )
 
 // Any is a loose type alias to interface{}
@@ -41,12 +58,21 @@ type Predicate func(item Any) bool
 //PredicateOfInt is a func that takes an int and returns a bool.
 type PredicateOfInt func(item int) bool
 
+// PredicateOfInt64 is a func that takes an int64 and returns a bool.
+type PredicateOfInt64 func(item int64) bool
+
+// PredicateOfUint is a func that takes a uint and returns a bool.
+type PredicateOfUint func(item uint) bool
+
 // PredicateOfFloat is a func that takes a float64 and returns a bool.
 type PredicateOfFloat func(item float64) bool
 
 // PredicateOfString is a func that takes a string and returns a bool.
 type PredicateOfString func(item string) bool
 
+// PredicateIndexed is a func that takes an element's index and the element, and returns a bool.
+type PredicateIndexed func(index int, item Any) bool
+
 // PredicateOfTime is a func that takes a time.Time and returns a bool.
 type PredicateOfTime func(item time.Time) bool
 
@@ -58,48 +84,205 @@ func Empty() *Assertions {
 // New returns a new instance of `Assertions`.
 func New(t *testing.T) *Assertions {
 	return &Assertions{
-		t:            t,
-		timerAbort:   make(chan bool),
-		timerAborted: make(chan bool),
+		t: t,
 	}
 }
 
-// Filtered returns a new instance of `Assertions`.
-func Filtered(t *testing.T, filter Filter) *Assertions {
-	CheckFilter(t, filter)
+// Filtered returns a new instance of `Assertions`, skipping the test if it doesn't belong to any enabled filter.
+// See `CheckFilter` for how the filters are applied.
+func Filtered(t *testing.T, filters ...Filter) *Assertions {
+	CheckFilter(t, filters...)
 	return &Assertions{
-		filter:       filter,
-		t:            t,
-		timerAbort:   make(chan bool),
-		timerAborted: make(chan bool),
+		filters: filters,
+		t:       t,
 	}
 }
 
 // Assertions is the main entry point for using the assertions library.
 type Assertions struct {
-	output       io.Writer
-	filter       Filter
-	t            *testing.T
-	timerAbort   chan bool
-	timerAborted chan bool
+	outputs outputWriters
+	filters []Filter
+	t       *testing.T
+	color   *bool
+	diff    bool
+	panics  bool
+
+	outputFormat  OutputFormat
+	caller        callerOptions
+	failFormatter FailFormatter
+
+	timeoutsLock sync.Mutex
+	timeouts     []timeoutBlock
+}
+
+// timeoutBlock is a timed block started with `StartTimeout`.
+type timeoutBlock struct {
+	abort chan struct{}
+	done  chan struct{}
 }
 
 // WithFilter sets the filter.
+// It is a shortcut for `WithFilters(filter)`.
 func (a *Assertions) WithFilter(filter Filter) *Assertions {
-	a.filter = filter
+	return a.WithFilters(filter)
+}
+
+// WithFilters sets the filters, skipping the test if it doesn't belong to any enabled filter.
+// See `CheckFilter` for how the filters are applied.
+func (a *Assertions) WithFilters(filters ...Filter) *Assertions {
+	a.filters = filters
+	if a.t != nil {
+		CheckFilter(a.t, filters...)
+	}
 	return a
 }
 
-// WithOutput sets the assertions output.
-// Error messages will be written to this in addition to the test handler.
+// Filters returns the filters.
+func (a *Assertions) Filters() []Filter {
+	return a.filters
+}
+
+// WithOutput adds an output.
+// Error messages will be written to the outputs in addition to the test handler.
 func (a *Assertions) WithOutput(w io.Writer) *Assertions {
-	a.output = w
+	return a.WithOutputs(w)
+}
+
+// WithOutputs adds outputs.
+// Error messages will be written to each output; an output that fails to write doesn't prevent writing to the others.
+func (a *Assertions) WithOutputs(writers ...io.Writer) *Assertions {
+	a.outputs = a.outputs.with(writers...)
 	return a
 }
 
-// Output returns the underlying output writer.
+// Output returns the output writer, or a writer over every output if there are more than one.
 func (a *Assertions) Output() io.Writer {
-	return a.output
+	return a.outputs.output()
+}
+
+// Outputs returns the output writers.
+func (a *Assertions) Outputs() []io.Writer {
+	return a.outputs
+}
+
+// WithOutputFormat sets the format failures are written to the output writer in.
+// With `FormatJSON`, each failure is written as a single json line; failures reported to the test are unchanged.
+func (a *Assertions) WithOutputFormat(format OutputFormat) *Assertions {
+	a.outputFormat = format
+	return a
+}
+
+// OutputFormat returns the format failures are written to the output writer in.
+func (a *Assertions) OutputFormat() OutputFormat {
+	return a.outputFormat
+}
+
+// WithCallerSkip sets a number of additional frames to skip when reporting where an assertion failed.
+// It is useful for test helpers that wrap the assertions, so failures are reported where the helper is called.
+// See also `AddCallerExclusion` to skip every frame in a package.
+func (a *Assertions) WithCallerSkip(frames int) *Assertions {
+	a.caller.skip = frames
+	return a
+}
+
+// CallerSkip returns the number of additional frames skipped when reporting where an assertion failed.
+func (a *Assertions) CallerSkip() int {
+	return a.caller.skip
+}
+
+// WithFullCallerPaths sets if failure locations should include the full path of files, instead of just the file name.
+func (a *Assertions) WithFullCallerPaths(enabled bool) *Assertions {
+	a.caller.fullPaths = enabled
+	return a
+}
+
+// FullCallerPaths returns if failure locations include the full path of files.
+func (a *Assertions) FullCallerPaths() bool {
+	return a.caller.fullPaths
+}
+
+// WithFailFormatter sets a formatter for failures, replacing the default multi-line format, e.g. to write json lines.
+// Failures formatted by the formatter are reported to the test and written to the outputs as returned;
+// with `FormatJSON`, failures are still written to the outputs as json.
+func (a *Assertions) WithFailFormatter(formatter FailFormatter) *Assertions {
+	a.failFormatter = formatter
+	return a
+}
+
+// FailFormatter returns the failure formatter.
+func (a *Assertions) FailFormatter() FailFormatter {
+	return a.failFormatter
+}
+
+// WithColor sets if failure output should use ansi color codes.
+// If unset, color is used unless the output is a file that is not a terminal.
+func (a *Assertions) WithColor(enabled bool) *Assertions {
+	a.color = &enabled
+	return a
+}
+
+// WithDiff sets if `Equal` failures should include a field by field diff of the objects.
+func (a *Assertions) WithDiff(enabled bool) *Assertions {
+	a.diff = enabled
+	return a
+}
+
+// Require returns a copy of the assertions whose failures always abort by panicking with the failure message,
+// instead of calling `t.FailNow`. Failures are still reported to the test, if one is set.
+// This is useful in setup code outside of tests, and in goroutines other than the test's, where `t.FailNow` doesn't work.
+func (a *Assertions) Require() *Assertions {
+	required := a.withTest(a.t)
+	required.panics = true
+	return required
+}
+
+// Run runs a subtest with assertions bound to the subtest's `*testing.T`.
+// The subtest assertions inherit the output, filters, color and diff settings.
+// It returns if the subtest succeeded; if there is no test, the body is called directly.
+func (a *Assertions) Run(name string, body func(a *Assertions)) bool {
+	if a.t == nil {
+		body(a.withTest(nil))
+		return true
+	}
+	return a.t.Run(name, func(t *testing.T) {
+		body(a.withTest(t))
+	})
+}
+
+// withTest returns a copy of the assertions settings bound to a given test.
+func (a *Assertions) withTest(t *testing.T) *Assertions {
+	return &Assertions{outputs: a.outputs, outputFormat: a.outputFormat, caller: a.caller, failFormatter: a.failFormatter, filters: a.filters, t: t, color: a.color, diff: a.diff, panics: a.panics}
+}
+
+// UseColor returns if failure output should use ansi color codes.
+func (a *Assertions) UseColor() bool {
+	return useColor(a.color, a.outputs)
+}
+
+// failSettings returns the settings failures are written with.
+func (a *Assertions) failSettings() failSettings {
+	return failSettings{
+		output:    a.outputs.writer(),
+		t:         a.t,
+		useColor:  a.UseColor(),
+		format:    a.outputFormat,
+		caller:    a.caller,
+		formatter: a.failFormatter,
+	}
+}
+
+// fail writes a failure.
+func (a *Assertions) fail(message string, userMessageComponents ...interface{}) {
+	fail(a.failSettings(), false, message, userMessageComponents...)
+}
+
+// failNow writes a failure and aborts the test.
+func (a *Assertions) failNow(message string, userMessageComponents ...interface{}) {
+	if a.panics {
+		failPanic(a.failSettings(), message, userMessageComponents...)
+		return
+	}
+	failNow(a.failSettings(), message, userMessageComponents...)
 }
 
 // assertion represents the actions to take for *each* assertion.
@@ -113,14 +296,31 @@ func (a *Assertions) assertion() {
 // They will typically return a bool to indicate if the assertion succeeded, or if you should consider the overall
 // test to still be a success.
 func (a *Assertions) NonFatal() *Optional { //golint you can bite me.
-	return &Optional{t: a.t, output: a.output}
+	return &Optional{t: a.t, outputs: a.outputs, outputFormat: a.outputFormat, caller: a.caller, failFormatter: a.failFormatter, color: a.color, diff: a.diff}
 }
 
 // NotNil asserts that a reference is not nil.
 func (a *Assertions) NotNil(object interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldNotBeNil(object); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// IsType asserts that an object has the same type as an exemplar, e.g. `IsType(Config{}, actual)`.
+func (a *Assertions) IsType(expected, actual interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeType(expected, actual); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// Implements asserts that an object implements an interface, given as a
+// nil pointer to the interface, e.g. `Implements((*io.Reader)(nil), actual)`.
+func (a *Assertions) Implements(interfaceObject, actual interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldImplement(interfaceObject, actual); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -128,7 +328,7 @@ func (a *Assertions) NotNil(object interface{}, userMessageComponents ...interfa
 func (a *Assertions) Nil(object interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeNil(object); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -136,7 +336,78 @@ func (a *Assertions) Nil(object interface{}, userMessageComponents ...interface{
 func (a *Assertions) Len(collection interface{}, length int, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldHaveLength(collection, length); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// LenAtLeast asserts that a collection has at least a given length.
+func (a *Assertions) LenAtLeast(collection interface{}, min int, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHaveLengthAtLeast(collection, min); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// LenAtMost asserts that a collection has at most a given length.
+func (a *Assertions) LenAtMost(collection interface{}, max int, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHaveLengthAtMost(collection, max); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// ChannelLen asserts that a channel has a given number of buffered items.
+func (a *Assertions) ChannelLen(ch interface{}, expected int, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHaveChannelLength(ch, expected); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// ReceivesWithin asserts that a value is received on a channel within a timeout, and returns the value.
+// A closed channel fails the assertion.
+func (a *Assertions) ReceivesWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) interface{} {
+	a.assertion()
+	value, didFail, message := shouldReceiveWithin(ch, timeout)
+	if didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+	return value
+}
+
+// DoesNotReceiveWithin asserts that no value is received on a channel within a timeout.
+// A closed channel fails the assertion.
+func (a *Assertions) DoesNotReceiveWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNotReceiveWithin(ch, timeout); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// ClosedWithin asserts that a channel is closed within a timeout.
+// Receiving a value before the channel closes fails the assertion.
+func (a *Assertions) ClosedWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeClosedWithin(ch, timeout); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// NotClosed asserts that a channel is not closed, without blocking.
+// If the channel has a buffered value, the value is consumed.
+func (a *Assertions) NotClosed(ch interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNotBeClosed(ch); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// MapContains asserts that a map contains every key in the expected entries
+// with a value equal to the expected value; the superset can have other keys.
+func (a *Assertions) MapContains(superset, expectedEntries interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldMapContain(superset, expectedEntries); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -144,7 +415,7 @@ func (a *Assertions) Len(collection interface{}, length int, userMessageComponen
 func (a *Assertions) Empty(collection interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeEmpty(collection); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -152,7 +423,39 @@ func (a *Assertions) Empty(collection interface{}, userMessageComponents ...inte
 func (a *Assertions) NotEmpty(collection interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldNotBeEmpty(collection); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// ValidUTF8 asserts that a byte slice is valid UTF-8.
+func (a *Assertions) ValidUTF8(b []byte, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeValidUTF8(b); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// ASCIIOnly asserts that a byte slice only contains ASCII characters.
+func (a *Assertions) ASCIIOnly(b []byte, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeASCIIOnly(b); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// Blank asserts that a string is empty or only contains whitespace.
+func (a *Assertions) Blank(value string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeBlank(value); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// NotBlank asserts that a string contains non-whitespace characters.
+func (a *Assertions) NotBlank(value string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNotBeBlank(value); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -160,7 +463,36 @@ func (a *Assertions) NotEmpty(collection interface{}, userMessageComponents ...i
 func (a *Assertions) Equal(expected interface{}, actual interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeEqual(expected, actual); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		if a.diff {
+			message = withDiff(message, expected, actual)
+		}
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// EqualValues asserts that two objects are equal after converting either one to the other's type.
+// Numbers of different types compare by value, e.g. `EqualValues(int32(5), int64(5))` passes
+// and `EqualValues(int64(300), int8(44))` fails.
+func (a *Assertions) EqualValues(expected interface{}, actual interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeEqualValues(expected, actual); didFail {
+		if a.diff {
+			message = withDiff(message, expected, actual)
+		}
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// ExactEqual asserts that two objects have identical types and are deeply equal.
+// Unlike `Equal`, the expected value is not converted to the actual value's type,
+// e.g. `ExactEqual(int64(1), int32(1))` fails because the types differ.
+func (a *Assertions) ExactEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeExactEqual(expected, actual); didFail {
+		if a.diff {
+			message = withDiff(message, expected, actual)
+		}
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -168,7 +500,7 @@ func (a *Assertions) Equal(expected interface{}, actual interface{}, userMessage
 func (a *Assertions) ReferenceEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeReferenceEqual(expected, actual); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -176,7 +508,7 @@ func (a *Assertions) ReferenceEqual(expected interface{}, actual interface{}, us
 func (a *Assertions) NotEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldNotBeEqual(expected, actual); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -184,7 +516,16 @@ func (a *Assertions) NotEqual(expected interface{}, actual interface{}, userMess
 func (a *Assertions) PanicEqual(expected interface{}, action func(), userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBePanicEqual(expected, action); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// PanicStackContains asserts that an action panics, and that the stack at the panic contains a substring,
+// e.g. the name of the function the panic should originate from.
+func (a *Assertions) PanicStackContains(substring string, action func(), userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldPanicStackContain(substring, action); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -192,7 +533,7 @@ func (a *Assertions) PanicEqual(expected interface{}, action func(), userMessage
 func (a *Assertions) Zero(value interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeZero(value); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -200,7 +541,34 @@ func (a *Assertions) Zero(value interface{}, userMessageComponents ...interface{
 func (a *Assertions) NotZero(value interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeNonZero(value); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// RequiredFieldsSet asserts that the named fields of a struct are not their zero values.
+// Fields can be nested with paths like `FieldEqual`, e.g. `Web.Port`; all unset fields are listed on failure.
+func (a *Assertions) RequiredFieldsSet(obj interface{}, fields []string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHaveRequiredFieldsSet(obj, fields); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// FieldEqual asserts that the field at a path in an object equals an expected value.
+// The path is dotted, with indices for slices and arrays, e.g. `user.addresses[0].zip`;
+// struct fields are matched by name or json tag, and maps must have string keys.
+func (a *Assertions) FieldEqual(object interface{}, path string, expected interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHaveFieldEqual(object, path, expected); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
+}
+
+// FieldNotZero asserts that the field at a path in an object is not its zero value, see `FieldEqual` for the path syntax.
+func (a *Assertions) FieldNotZero(object interface{}, path string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHaveFieldNotZero(object, path); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -208,7 +576,7 @@ func (a *Assertions) NotZero(value interface{}, userMessageComponents ...interfa
 func (a *Assertions) True(object bool, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeTrue(object); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -216,7 +584,7 @@ func (a *Assertions) True(object bool, userMessageComponents ...interface{}) {
 func (a *Assertions) False(object bool, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeFalse(object); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
@@ -229,521 +597,1640 @@ func (a *Assertions) False(object bool, userMessageComponents ...interface{}) {
 func (a *Assertions) InDelta(f0, f1, delta float64, userMessageComponents ...interface{}) {
 	a.assertion()
 	if didFail, message := shouldBeInDelta(f0, f1, delta); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// InTimeDelta asserts that times t1 and t2 are within a delta.
-func (a *Assertions) InTimeDelta(t1, t2 time.Time, delta time.Duration, userMessageComponents ...interface{}) {
+// NotInDelta asserts that two floats are not within a delta, i.e. that their absolute difference is greater than delta.
+// NaN is never considered to be outside a delta, and fails the assertion.
+func (a *Assertions) NotInDelta(f0, f1, delta float64, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldBeInTimeDelta(t1, t2, delta); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldNotBeInDelta(f0, f1, delta); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// FileExists asserts that a file exists at a given filepath on disk.
-func (a *Assertions) FileExists(filepath string, userMessageComponents ...interface{}) {
+// MapInDelta asserts that two float maps have the same keys, and that the values for each key are within a delta.
+// All keys with values outside the delta, and any missing or unexpected keys, are reported.
+func (a *Assertions) MapInDelta(expected, actual map[string]float64, delta float64, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := fileShouldExist(filepath); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeMapInDelta(expected, actual, delta); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// Contains asserts that a substring is present in a corpus.
-func (a *Assertions) Contains(corpus, substring string, userMessageComponents ...interface{}) {
+// InEpsilon asserts that two floats are within a relative tolerance, i.e. that `|expected-actual| / |expected|` is at most epsilon.
+// If expected is zero, the absolute difference is compared to epsilon instead.
+func (a *Assertions) InEpsilon(expected, actual, epsilon float64, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldContain(corpus, substring); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeInEpsilon(expected, actual, epsilon); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// NotContains asserts that a substring is present in a corpus.
-func (a *Assertions) NotContains(corpus, substring string, userMessageComponents ...interface{}) {
+// WithinPercent asserts that a number is within a percentage of an expected number, e.g. `WithinPercent(98, 100, 5)`.
+// If the expected number is zero, the actual number must also be zero.
+func (a *Assertions) WithinPercent(actual, expected, percent float64, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldNotContain(corpus, substring); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeWithinPercent(actual, expected, percent); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// Any applies a predicate.
-func (a *Assertions) Any(target interface{}, predicate Predicate, userMessageComponents ...interface{}) {
+// Positive asserts that a number is greater than zero.
+func (a *Assertions) Positive(value interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAny(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBePositive(value); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// AnyOfInt applies a predicate.
-func (a *Assertions) AnyOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) {
+// Negative asserts that a number is less than zero.
+func (a *Assertions) Negative(value interface{}, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAnyOfInt(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeNegative(value); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// AnyOfFloat64 applies a predicate.
-func (a *Assertions) AnyOfFloat64(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) {
+// TimeBefore asserts that a time is before another time.
+func (a *Assertions) TimeBefore(t1, t2 time.Time, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAnyOfFloat(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeTimeBefore(t1, t2); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// AnyOfString applies a predicate.
-func (a *Assertions) AnyOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) {
+// TimeAfter asserts that a time is after another time.
+func (a *Assertions) TimeAfter(t1, t2 time.Time, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAnyOfString(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeTimeAfter(t1, t2); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// All applies a predicate.
-func (a *Assertions) All(target interface{}, predicate Predicate, userMessageComponents ...interface{}) {
+// TimeEqual asserts that two times are the same instant, as with `time.Time.Equal`.
+// Unlike `Equal`, the location and monotonic clock reading of the times are not compared.
+func (a *Assertions) TimeEqual(t1, t2 time.Time, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAll(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeTimeEqual(t1, t2); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// AllOfInt applies a predicate.
-func (a *Assertions) AllOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) {
+// SameDay asserts that two times are on the same calendar day, ignoring the time of day.
+// If both times have the same location, they are compared in that location, otherwise in UTC.
+func (a *Assertions) SameDay(t0, t1 time.Time, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAllOfInt(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeSameDay(t0, t1, nil); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// AllOfFloat64 applies a predicate.
-func (a *Assertions) AllOfFloat64(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) {
+// SameDayIn asserts that two times are on the same calendar day in a given location, ignoring the time of day.
+// If the location is nil, the times are compared as with `SameDay`.
+func (a *Assertions) SameDayIn(t0, t1 time.Time, location *time.Location, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAllOfFloat(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeSameDay(t0, t1, location); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// AllOfString applies a predicate.
-func (a *Assertions) AllOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) {
+// IsNaN asserts that a float is NaN.
+func (a *Assertions) IsNaN(f float64, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldAllOfString(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeNaN(f); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// None applies a predicate.
-func (a *Assertions) None(target interface{}, predicate Predicate, userMessageComponents ...interface{}) {
+// NotNaN asserts that a float is not NaN.
+func (a *Assertions) NotNaN(f float64, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldNone(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldNotBeNaN(f); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// NoneOfInt applies a predicate.
-func (a *Assertions) NoneOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) {
+// IsInf asserts that a float is an infinity, according to sign.
+// If sign > 0, the float must be positive infinity, if sign < 0, negative infinity, and if sign == 0, either infinity.
+func (a *Assertions) IsInf(f float64, sign int, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldNoneOfInt(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeInf(f, sign); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// NoneOfFloat64 applies a predicate.
-func (a *Assertions) NoneOfFloat64(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) {
+// NotInf asserts that a float is not an infinity, according to sign.
+// If sign > 0, the float must not be positive infinity, if sign < 0, negative infinity, and if sign == 0, either infinity.
+func (a *Assertions) NotInf(f float64, sign int, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldNoneOfFloat(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldNotBeInf(f, sign); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// NoneOfString applies a predicate.
-func (a *Assertions) NoneOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) {
+// InTimeDelta asserts that times t1 and t2 are within a delta.
+func (a *Assertions) InTimeDelta(t1, t2 time.Time, delta time.Duration, userMessageComponents ...interface{}) {
 	a.assertion()
-	if didFail, message := shouldNoneOfString(target, predicate); didFail {
-		failNow(a.output, a.t, message, userMessageComponents...)
+	if didFail, message := shouldBeInTimeDelta(t1, t2, delta); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
 }
 
-// FailNow forces a test failure (useful for debugging).
-func (a *Assertions) FailNow(userMessageComponents ...interface{}) {
-	failNow(a.output, a.t, "Fatal Assertion Failed", userMessageComponents...)
+// InTimeDeltaNow asserts that a time is within a delta of the current time, e.g. that a timestamp is recent.
+func (a *Assertions) InTimeDeltaNow(t time.Time, delta time.Duration, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeInTimeDelta(t, time.Now(), delta); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
 }
 
-// StartTimeout starts a timed block.
-func (a *Assertions) StartTimeout(timeout time.Duration, userMessageComponents ...interface{}) {
-	ticker := time.NewTimer(timeout)
-	go func() {
-		select {
-		case <-ticker.C:
-			a.t.Errorf("Timeout Reached")
-			a.t.FailNow()
-		case <-a.timerAbort:
-			a.timerAborted <- true
-			return
-		}
-	}()
+// FileExists asserts that a file exists at a given filepath on disk.
+func (a *Assertions) FileExists(filepath string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := fileShouldExist(filepath); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
 }
 
-// EndTimeout marks a timed block as complete.
-func (a *Assertions) EndTimeout() {
-	a.timerAbort <- true
-	<-a.timerAborted
+// FileNotExists asserts that a file does not exist at a given filepath on disk.
+func (a *Assertions) FileNotExists(filepath string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := fileShouldNotExist(filepath); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
 }
 
-// Optional is an assertion type that does not stop a test if an assertion fails, simply outputs the error.
-type Optional struct {
-	output io.Writer
-	t      *testing.T
+// DirExists asserts that a directory exists at a given filepath on disk.
+func (a *Assertions) DirExists(filepath string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := dirShouldExist(filepath); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
 }
 
-// WithOutput sets an output to capture error output.
-func (o *Optional) WithOutput(w io.Writer) *Optional {
-	o.output = w
-	return o
+// FileContains asserts that a file on disk at a given filepath contains a substring.
+func (a *Assertions) FileContains(filepath, substring string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := fileShouldContain(filepath, substring); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
 }
 
-// Output returns the underlying output writer.
-func (o *Optional) Output() io.Writer {
-	return o.output
+// FileContents asserts that a file on disk at a given filepath has the expected contents.
+// The failure message shows the first difference.
+func (a *Assertions) FileContents(filepath string, expected []byte, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := fileShouldHaveContents(filepath, expected); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
 }
 
-func (o *Optional) assertion() {
-	Increment()
+// FileSizeGreaterThan asserts that a file on disk at a given filepath is larger than a given number of bytes.
+func (a *Assertions) FileSizeGreaterThan(filepath string, size int64, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := fileShouldHaveSizeGreaterThan(filepath, size); didFail {
+		a.failNow(message, userMessageComponents...)
+	}
 }
 
-// Nil asserts the object is nil.
-func (o *Optional) Nil(object interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeNil(object); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// Contains asserts that a substring is present in a corpus.
+func (a *Assertions) Contains(corpus, substring string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldContain(corpus, substring); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// NotNil asserts the object is not nil.
-func (o *Optional) NotNil(object interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldNotBeNil(object); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// NotContains asserts that a substring is present in a corpus.
+func (a *Assertions) NotContains(corpus, substring string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNotContain(corpus, substring); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// Len asserts that the collection has a specified length.
-func (o *Optional) Len(collection interface{}, length int, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldHaveLength(collection, length); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// ContainsFold asserts that a substring is present in a corpus, under Unicode case folding.
+func (a *Assertions) ContainsFold(corpus, substring string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldContainFold(corpus, substring); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// Empty asserts that a collection is empty.
-func (o *Optional) Empty(collection interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeEmpty(collection); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
-	}
-	return true
-}
-
-// NotEmpty asserts that a collection is not empty.
-func (o *Optional) NotEmpty(collection interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldNotBeEmpty(collection); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
-	}
-	return true
-}
-
-// Equal asserts that two objects are equal.
-func (o *Optional) Equal(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeEqual(expected, actual); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// NotContainsFold asserts that a substring is not present in a corpus, under Unicode case folding.
+func (a *Assertions) NotContainsFold(corpus, substring string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNotContainFold(corpus, substring); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// ReferenceEqual asserts that two objects are the same underlying reference in memory.
-func (o *Optional) ReferenceEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeReferenceEqual(expected, actual); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// HasPrefix asserts that a corpus starts with a prefix.
+func (a *Assertions) HasPrefix(corpus, prefix string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHavePrefix(corpus, prefix); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// NotEqual asserts that two objects are not equal.
-func (o *Optional) NotEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldNotBeEqual(expected, actual); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// HasSuffix asserts that a corpus ends with a suffix.
+func (a *Assertions) HasSuffix(corpus, suffix string, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldHaveSuffix(corpus, suffix); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// PanicEqual asserts the panic emitted by an actin equals an expected value.
-func (o *Optional) PanicEqual(expected interface{}, action func(), userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBePanicEqual(expected, action); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AnyIndexed applies a predicate that also receives each element's index.
+func (a *Assertions) AnyIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAnyIndexed(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// Zero asserts that a value is the default value.
-func (o *Optional) Zero(value interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeZero(value); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// Any applies a predicate.
+func (a *Assertions) Any(target interface{}, predicate Predicate, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAny(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// NotZero asserts that a value is not the default value.
-func (o *Optional) NotZero(value interface{}, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeNonZero(value); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AnyOfInt applies a predicate.
+func (a *Assertions) AnyOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAnyOfInt(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// True asserts that a bool is false.
-func (o *Optional) True(object bool, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeTrue(object); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AnyOfInt64 applies a predicate.
+func (a *Assertions) AnyOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAnyOfInt64(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// False asserts that a bool is false.
-func (o *Optional) False(object bool, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeFalse(object); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AnyOfUint applies a predicate.
+func (a *Assertions) AnyOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAnyOfUint(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// InDelta returns if two float64s are separated by a given delta.
-func (o *Optional) InDelta(a, b, delta float64, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeInDelta(a, b, delta); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AnyOfFloat64 applies a predicate.
+func (a *Assertions) AnyOfFloat64(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAnyOfFloat(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// InTimeDelta returns if two times are separated by a given delta.
-func (o *Optional) InTimeDelta(a, b time.Time, delta time.Duration, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldBeInTimeDelta(a, b, delta); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AnyOfString applies a predicate.
+func (a *Assertions) AnyOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAnyOfString(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// FileExists asserts that a file exists on disk at a given filepath.
-func (o *Optional) FileExists(filepath string, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := fileShouldExist(filepath); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// UniqueBy asserts that the elements of a slice or array are distinct by a key,
+// i.e. that no two elements share the key returned by `key`.
+func (a *Assertions) UniqueBy(target interface{}, key func(interface{}) interface{}, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldBeUniqueBy(target, key); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// Contains checks if a substring is present in a corpus.
-func (o *Optional) Contains(corpus, substring string, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldContain(corpus, substring); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AllIndexed applies a predicate that also receives each element's index.
+func (a *Assertions) AllIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAllIndexed(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// NotContains checks if a substring is not present in a corpus.
-func (o *Optional) NotContains(corpus, substring string, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldNotContain(corpus, substring); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// All applies a predicate.
+func (a *Assertions) All(target interface{}, predicate Predicate, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAll(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// Any applies a predicate.
-func (o *Optional) Any(target interface{}, predicate Predicate, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAny(target, predicate); didFail {
-		fail(o.output, o.t, prefixOptional(message), userMessageComponents...)
-		return false
+// AllOfInt applies a predicate.
+func (a *Assertions) AllOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAllOfInt(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// AnyOfInt applies a predicate.
-func (o *Optional) AnyOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAnyOfInt(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// AllOfInt64 applies a predicate.
+func (a *Assertions) AllOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAllOfInt64(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// AnyOfFloat applies a predicate.
-func (o *Optional) AnyOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAnyOfFloat(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// AllOfUint applies a predicate.
+func (a *Assertions) AllOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAllOfUint(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// AnyOfString applies a predicate.
-func (o *Optional) AnyOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAnyOfString(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// AllOfFloat64 applies a predicate.
+func (a *Assertions) AllOfFloat64(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAllOfFloat(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// All applies a predicate.
-func (o *Optional) All(target interface{}, predicate Predicate, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAll(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// AllOfString applies a predicate.
+func (a *Assertions) AllOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldAllOfString(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// AllOfInt applies a predicate.
-func (o *Optional) AllOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAllOfInt(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// NoneIndexed applies a predicate that also receives each element's index.
+func (a *Assertions) NoneIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNoneIndexed(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// AllOfFloat applies a predicate.
-func (o *Optional) AllOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAllOfFloat(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// None applies a predicate.
+func (a *Assertions) None(target interface{}, predicate Predicate, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNone(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// AllOfString applies a predicate.
-func (o *Optional) AllOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldAllOfString(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// NoneOfInt applies a predicate.
+func (a *Assertions) NoneOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNoneOfInt(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// None applies a predicate.
-func (o *Optional) None(target interface{}, predicate Predicate, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldNone(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// NoneOfInt64 applies a predicate.
+func (a *Assertions) NoneOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNoneOfInt64(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// NoneOfInt applies a predicate.
-func (o *Optional) NoneOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) bool {
-	o.assertion()
-	if didFail, message := shouldNoneOfInt(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+// NoneOfUint applies a predicate.
+func (a *Assertions) NoneOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) {
+	a.assertion()
+	if didFail, message := shouldNoneOfUint(target, predicate); didFail {
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// NoneOfFloat applies a predicate.
-func (o *Optional) NoneOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) bool {
-	o.assertion()
+// NoneOfFloat64 applies a predicate.
+func (a *Assertions) NoneOfFloat64(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) {
+	a.assertion()
 	if didFail, message := shouldNoneOfFloat(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
 // NoneOfString applies a predicate.
-func (o *Optional) NoneOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) bool {
-	o.assertion()
+func (a *Assertions) NoneOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) {
+	a.assertion()
 	if didFail, message := shouldNoneOfString(target, predicate); didFail {
-		fail(o.output, o.t, message, userMessageComponents...)
-		return false
+		a.failNow(message, userMessageComponents...)
 	}
-	return true
 }
 
-// Fail manually injects a failure.
-func (o *Optional) Fail(userMessageComponents ...interface{}) {
-	fail(o.output, o.t, prefixOptional("Assertion Failed"), userMessageComponents...)
+// FailNow forces a test failure (useful for debugging).
+func (a *Assertions) FailNow(userMessageComponents ...interface{}) {
+	a.failNow("Fatal Assertion Failed", userMessageComponents...)
 }
 
-// --------------------------------------------------------------------------------
-// OUTPUT
-// --------------------------------------------------------------------------------
+// WithTimeout runs a timed block, failing if the block does not finish within the timeout.
+// The context passed to the action is cancelled at the deadline; the action should return
+// once it is done, as the block is not abandoned.
+// Timed blocks can be nested, and the timer is always stopped when the block returns,
+// including if an assertion within the block fails.
+func (a *Assertions) WithTimeout(timeout time.Duration, action func(context.Context), userMessageComponents ...interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-func failNow(w io.Writer, t *testing.T, message string, userMessageComponents ...interface{}) {
-	fail(w, t, message, userMessageComponents...)
-	if t != nil {
-		t.FailNow()
-	} else {
-		panic(fmt.Errorf(message))
+	action(ctx)
+
+	a.assertion()
+	if ctx.Err() == context.DeadlineExceeded {
+		a.failNow("Timeout Reached", userMessageComponents...)
 	}
 }
 
-func fail(w io.Writer, t *testing.T, message string, userMessageComponents ...interface{}) {
-	errorTrace := strings.Join(callerInfo(), "\n\t")
+// StartTimeout starts a timed block; it must be paired with a call to `EndTimeout`.
+// Timed blocks can be nested, with each `EndTimeout` ending the most recently started block.
+//
+// The timeout is reported as a test error (it cannot abort the test from the timer's goroutine), and is
+// not reported if the test has already failed. Prefer `WithTimeout`, which does not need to be paired.
+func (a *Assertions) StartTimeout(timeout time.Duration, userMessageComponents ...interface{}) {
+	block := timeoutBlock{
+		abort: make(chan struct{}, 1),
+		done:  make(chan struct{}),
+	}
+	a.timeoutsLock.Lock()
+	a.timeouts = append(a.timeouts, block)
+	a.timeoutsLock.Unlock()
 
-	if len(errorTrace) == 0 {
-		errorTrace = "Unknown"
+	timer := time.NewTimer(timeout)
+	go func() {
+		defer close(block.done)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			if a.t != nil && a.t.Failed() {
+				return
+			}
+			a.assertion()
+			a.fail("Timeout Reached", userMessageComponents...)
+		case <-block.abort:
+		}
+	}()
+}
+
+// EndTimeout marks the most recently started timed block as complete.
+func (a *Assertions) EndTimeout() {
+	a.timeoutsLock.Lock()
+	if len(a.timeouts) == 0 {
+		a.timeoutsLock.Unlock()
+		return
 	}
+	block := a.timeouts[len(a.timeouts)-1]
+	a.timeouts = a.timeouts[:len(a.timeouts)-1]
+	a.timeoutsLock.Unlock()
 
-	assertionFailedLabel := color("Assertion Failed!", RED)
-	locationLabel := color("Assert Location", GRAY)
-	assertionLabel := color("Assertion", GRAY)
-	messageLabel := color("Message", GRAY)
+	block.abort <- struct{}{}
+	<-block.done
+}
 
-	erasure := fmt.Sprintf("\r%s", getClearString())
-	userMessage := fmt.Sprint(userMessageComponents...)
+// Optional is an assertion type that does not stop a test if an assertion fails, simply outputs the error.
+type Optional struct {
+	outputs       outputWriters
+	outputFormat  OutputFormat
+	caller        callerOptions
+	failFormatter FailFormatter
+	t             *testing.T
+	color         *bool
+	diff          bool
+
+	recordLock     sync.Mutex
+	recordFailures bool
+	checks         int
+	failures       []OptionalFailure
+}
 
-	if len(userMessage) > 0 {
-		errorFormat := `%s
-%s
+// OptionalFailure is a failure recorded by an `Optional` that records failures.
+type OptionalFailure struct {
+	Message     string
+	Location    string
+	UserMessage string
+}
+
+// WithOutput adds an output to capture error output.
+func (o *Optional) WithOutput(w io.Writer) *Optional {
+	return o.WithOutputs(w)
+}
+
+// WithOutputs adds outputs to capture error output.
+func (o *Optional) WithOutputs(writers ...io.Writer) *Optional {
+	o.outputs = o.outputs.with(writers...)
+	return o
+}
+
+// Output returns the output writer, or a writer over every output if there are more than one.
+func (o *Optional) Output() io.Writer {
+	return o.outputs.output()
+}
+
+// Outputs returns the output writers.
+func (o *Optional) Outputs() []io.Writer {
+	return o.outputs
+}
+
+// WithOutputFormat sets the format failures are written to the output writer in.
+// With `FormatJSON`, each failure is written as a single json line; failures reported to the test are unchanged.
+func (o *Optional) WithOutputFormat(format OutputFormat) *Optional {
+	o.outputFormat = format
+	return o
+}
+
+// OutputFormat returns the format failures are written to the output writer in.
+func (o *Optional) OutputFormat() OutputFormat {
+	return o.outputFormat
+}
+
+// WithFailFormatter sets a formatter for failures, replacing the default multi-line format.
+func (o *Optional) WithFailFormatter(formatter FailFormatter) *Optional {
+	o.failFormatter = formatter
+	return o
+}
+
+// FailFormatter returns the failure formatter.
+func (o *Optional) FailFormatter() FailFormatter {
+	return o.failFormatter
+}
+
+// WithColor sets if failure output should use ansi color codes.
+// If unset, color is used unless the output is a file that is not a terminal.
+func (o *Optional) WithColor(enabled bool) *Optional {
+	o.color = &enabled
+	return o
+}
+
+// WithDiff sets if `Equal` failures should include a field by field diff of the objects.
+func (o *Optional) WithDiff(enabled bool) *Optional {
+	o.diff = enabled
+	return o
+}
+
+// UseColor returns if failure output should use ansi color codes.
+func (o *Optional) UseColor() bool {
+	return useColor(o.color, o.outputs)
+}
+
+// WithRecordFailures sets if failures should be recorded instead of written as they happen.
+// Recorded failures are written in a summary, and fail the test once, when `Report` is called.
+// It is safe to share an optional that records failures between parallel subtests.
+func (o *Optional) WithRecordFailures(enabled bool) *Optional {
+	o.recordLock.Lock()
+	defer o.recordLock.Unlock()
+	o.recordFailures = enabled
+	return o
+}
+
+// RecordFailures returns if failures are recorded instead of written as they happen.
+func (o *Optional) RecordFailures() bool {
+	o.recordLock.Lock()
+	defer o.recordLock.Unlock()
+	return o.recordFailures
+}
+
+// Failures returns the recorded failures.
+func (o *Optional) Failures() []OptionalFailure {
+	o.recordLock.Lock()
+	defer o.recordLock.Unlock()
+	return append([]OptionalFailure(nil), o.failures...)
+}
+
+// Report writes a summary of the checks and recorded failures to the output,
+// and fails the test once if any failures were recorded.
+// It returns true if there were no failures.
+func (o *Optional) Report() bool {
+	o.recordLock.Lock()
+	checks, failures := o.checks, append([]OptionalFailure(nil), o.failures...)
+	o.recordLock.Unlock()
+
+	report := optionalReport(checks, failures, o.UseColor())
+	if w := o.outputs.writer(); w != nil {
+		fmt.Fprint(w, report)
+	}
+	if len(failures) == 0 {
+		return true
+	}
+	if o.t != nil {
+		o.t.Error(report)
+	}
+	return false
+}
+
+// fail writes a failure, or records it if the optional records failures.
+func (o *Optional) fail(message string, userMessageComponents ...interface{}) {
+	if o.RecordFailures() {
+		o.record(message, userMessageComponents...)
+		return
+	}
+	fail(o.failSettings(), false, message, userMessageComponents...)
+}
+
+// failSettings returns the settings failures are written with.
+func (o *Optional) failSettings() failSettings {
+	return failSettings{
+		output:    o.outputs.writer(),
+		t:         o.t,
+		useColor:  o.UseColor(),
+		format:    o.outputFormat,
+		caller:    o.caller,
+		formatter: o.failFormatter,
+	}
+}
+
+// record records a failure.
+func (o *Optional) record(message string, userMessageComponents ...interface{}) {
+	incrementFailed()
+	location := strings.Join(callerInfo(o.caller), ", ")
+	if len(location) == 0 {
+		location = "Unknown"
+	}
+
+	o.recordLock.Lock()
+	defer o.recordLock.Unlock()
+	o.failures = append(o.failures, OptionalFailure{
+		Message:     message,
+		Location:    location,
+		UserMessage: fmt.Sprint(userMessageComponents...),
+	})
+}
+
+func (o *Optional) assertion() {
+	Increment()
+	o.recordLock.Lock()
+	o.checks++
+	o.recordLock.Unlock()
+}
+
+// Nil asserts the object is nil.
+func (o *Optional) Nil(object interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeNil(object); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotNil asserts the object is not nil.
+func (o *Optional) NotNil(object interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeNil(object); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// IsType asserts that an object has the same type as an exemplar, e.g. `IsType(Config{}, actual)`.
+func (o *Optional) IsType(expected, actual interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeType(expected, actual); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Implements asserts that an object implements an interface, given as a
+// nil pointer to the interface, e.g. `Implements((*io.Reader)(nil), actual)`.
+func (o *Optional) Implements(interfaceObject, actual interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldImplement(interfaceObject, actual); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Len asserts that the collection has a specified length.
+func (o *Optional) Len(collection interface{}, length int, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveLength(collection, length); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// LenAtLeast returns if a collection has at least a given length.
+func (o *Optional) LenAtLeast(collection interface{}, min int, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveLengthAtLeast(collection, min); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// LenAtMost returns if a collection has at most a given length.
+func (o *Optional) LenAtMost(collection interface{}, max int, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveLengthAtMost(collection, max); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ChannelLen asserts that a channel has a given number of buffered items.
+func (o *Optional) ChannelLen(ch interface{}, expected int, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveChannelLength(ch, expected); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ReceivesWithin asserts that a value is received on a channel within a timeout.
+// It returns the value received, and if the assertion passed.
+// A closed channel fails the assertion.
+func (o *Optional) ReceivesWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) (interface{}, bool) {
+	o.assertion()
+	value, didFail, message := shouldReceiveWithin(ch, timeout)
+	if didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return value, false
+	}
+	return value, true
+}
+
+// DoesNotReceiveWithin asserts that no value is received on a channel within a timeout.
+// A closed channel fails the assertion.
+func (o *Optional) DoesNotReceiveWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotReceiveWithin(ch, timeout); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ClosedWithin asserts that a channel is closed within a timeout.
+// Receiving a value before the channel closes fails the assertion.
+func (o *Optional) ClosedWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeClosedWithin(ch, timeout); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotClosed asserts that a channel is not closed, without blocking.
+// If the channel has a buffered value, the value is consumed.
+func (o *Optional) NotClosed(ch interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeClosed(ch); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// MapContains asserts that a map contains every key in the expected entries
+// with a value equal to the expected value; the superset can have other keys.
+func (o *Optional) MapContains(superset, expectedEntries interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldMapContain(superset, expectedEntries); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Empty asserts that a collection is empty.
+func (o *Optional) Empty(collection interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeEmpty(collection); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotEmpty asserts that a collection is not empty.
+func (o *Optional) NotEmpty(collection interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeEmpty(collection); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ValidUTF8 returns if a byte slice is valid UTF-8.
+func (o *Optional) ValidUTF8(b []byte, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeValidUTF8(b); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ASCIIOnly returns if a byte slice only contains ASCII characters.
+func (o *Optional) ASCIIOnly(b []byte, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeASCIIOnly(b); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Blank asserts that a string is empty or only contains whitespace.
+func (o *Optional) Blank(value string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeBlank(value); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotBlank asserts that a string contains non-whitespace characters.
+func (o *Optional) NotBlank(value string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeBlank(value); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Equal asserts that two objects are equal.
+func (o *Optional) Equal(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeEqual(expected, actual); didFail {
+		if o.diff {
+			message = withDiff(message, expected, actual)
+		}
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// EqualValues asserts that two objects are equal after converting either one to the other's type.
+// Numbers of different types compare by value, e.g. `EqualValues(int32(5), int64(5))` passes
+// and `EqualValues(int64(300), int8(44))` fails.
+func (o *Optional) EqualValues(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeEqualValues(expected, actual); didFail {
+		if o.diff {
+			message = withDiff(message, expected, actual)
+		}
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ExactEqual returns if two objects have identical types and are deeply equal.
+// Unlike `Equal`, the expected value is not converted to the actual value's type,
+// e.g. `ExactEqual(int64(1), int32(1))` fails because the types differ.
+func (o *Optional) ExactEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeExactEqual(expected, actual); didFail {
+		if o.diff {
+			message = withDiff(message, expected, actual)
+		}
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ReferenceEqual asserts that two objects are the same underlying reference in memory.
+func (o *Optional) ReferenceEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeReferenceEqual(expected, actual); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotEqual asserts that two objects are not equal.
+func (o *Optional) NotEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeEqual(expected, actual); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// PanicEqual asserts the panic emitted by an actin equals an expected value.
+func (o *Optional) PanicEqual(expected interface{}, action func(), userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBePanicEqual(expected, action); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// PanicStackContains asserts that an action panics, and that the stack at the panic contains a substring,
+// e.g. the name of the function the panic should originate from.
+func (o *Optional) PanicStackContains(substring string, action func(), userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldPanicStackContain(substring, action); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Zero asserts that a value is the default value.
+func (o *Optional) Zero(value interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeZero(value); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotZero asserts that a value is not the default value.
+func (o *Optional) NotZero(value interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeNonZero(value); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// RequiredFieldsSet asserts that the named fields of a struct are not their zero values.
+// Fields can be nested with paths like `FieldEqual`, e.g. `Web.Port`; all unset fields are listed on failure.
+func (o *Optional) RequiredFieldsSet(obj interface{}, fields []string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveRequiredFieldsSet(obj, fields); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// FieldEqual returns if the field at a path in an object equals an expected value.
+// The path is dotted, with indices for slices and arrays, e.g. `user.addresses[0].zip`;
+// struct fields are matched by name or json tag, and maps must have string keys.
+func (o *Optional) FieldEqual(object interface{}, path string, expected interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveFieldEqual(object, path, expected); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// FieldNotZero returns if the field at a path in an object is not its zero value, see `FieldEqual` for the path syntax.
+func (o *Optional) FieldNotZero(object interface{}, path string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveFieldNotZero(object, path); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// True asserts that a bool is false.
+func (o *Optional) True(object bool, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeTrue(object); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// False asserts that a bool is false.
+func (o *Optional) False(object bool, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeFalse(object); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// InDelta returns if two float64s are separated by a given delta.
+func (o *Optional) InDelta(a, b, delta float64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeInDelta(a, b, delta); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotInDelta returns if two floats are not within a delta, i.e. that their absolute difference is greater than delta.
+// NaN is never considered to be outside a delta, and fails the assertion.
+func (o *Optional) NotInDelta(f0, f1, delta float64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeInDelta(f0, f1, delta); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// MapInDelta returns if two float maps have the same keys, and that the values for each key are within a delta.
+// All keys with values outside the delta, and any missing or unexpected keys, are reported.
+func (o *Optional) MapInDelta(expected, actual map[string]float64, delta float64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeMapInDelta(expected, actual, delta); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// InEpsilon returns if two floats are within a relative tolerance, i.e. that `|expected-actual| / |expected|` is at most epsilon.
+// If expected is zero, the absolute difference is compared to epsilon instead.
+func (o *Optional) InEpsilon(expected, actual, epsilon float64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeInEpsilon(expected, actual, epsilon); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// WithinPercent returns if a number is within a percentage of an expected number, e.g. `WithinPercent(98, 100, 5)`.
+// If the expected number is zero, the actual number must also be zero.
+func (o *Optional) WithinPercent(actual, expected, percent float64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeWithinPercent(actual, expected, percent); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Positive returns if a number is greater than zero.
+func (o *Optional) Positive(value interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBePositive(value); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Negative returns if a number is less than zero.
+func (o *Optional) Negative(value interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeNegative(value); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// TimeBefore returns if a time is before another time.
+func (o *Optional) TimeBefore(t1, t2 time.Time, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeTimeBefore(t1, t2); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// TimeAfter returns if a time is after another time.
+func (o *Optional) TimeAfter(t1, t2 time.Time, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeTimeAfter(t1, t2); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// TimeEqual returns if two times are the same instant, as with `time.Time.Equal`.
+// Unlike `Equal`, the location and monotonic clock reading of the times are not compared.
+func (o *Optional) TimeEqual(t1, t2 time.Time, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeTimeEqual(t1, t2); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// SameDay returns if two times are on the same calendar day, ignoring the time of day.
+// If both times have the same location, they are compared in that location, otherwise in UTC.
+func (o *Optional) SameDay(t0, t1 time.Time, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeSameDay(t0, t1, nil); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// SameDayIn returns if two times are on the same calendar day in a given location, ignoring the time of day.
+// If the location is nil, the times are compared as with `SameDay`.
+func (o *Optional) SameDayIn(t0, t1 time.Time, location *time.Location, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeSameDay(t0, t1, location); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// IsNaN returns if a float is NaN.
+func (o *Optional) IsNaN(f float64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeNaN(f); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotNaN returns if a float is not NaN.
+func (o *Optional) NotNaN(f float64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeNaN(f); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// IsInf returns if a float is an infinity, according to sign.
+// If sign > 0, the float must be positive infinity, if sign < 0, negative infinity, and if sign == 0, either infinity.
+func (o *Optional) IsInf(f float64, sign int, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeInf(f, sign); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotInf returns if a float is not an infinity, according to sign.
+// If sign > 0, the float must not be positive infinity, if sign < 0, negative infinity, and if sign == 0, either infinity.
+func (o *Optional) NotInf(f float64, sign int, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotBeInf(f, sign); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// InTimeDelta returns if two times are separated by a given delta.
+func (o *Optional) InTimeDelta(a, b time.Time, delta time.Duration, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeInTimeDelta(a, b, delta); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// InTimeDeltaNow returns if a time is within a delta of the current time, e.g. that a timestamp is recent.
+func (o *Optional) InTimeDeltaNow(t time.Time, delta time.Duration, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeInTimeDelta(t, time.Now(), delta); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// FileExists asserts that a file exists on disk at a given filepath.
+func (o *Optional) FileExists(filepath string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := fileShouldExist(filepath); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// FileNotExists asserts that a file does not exist on disk at a given filepath.
+func (o *Optional) FileNotExists(filepath string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := fileShouldNotExist(filepath); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// DirExists asserts that a directory exists on disk at a given filepath.
+func (o *Optional) DirExists(filepath string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := dirShouldExist(filepath); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// FileContains checks if a file on disk at a given filepath contains a substring.
+func (o *Optional) FileContains(filepath, substring string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := fileShouldContain(filepath, substring); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// FileContents checks if a file on disk at a given filepath has the expected contents.
+// The failure message shows the first difference.
+func (o *Optional) FileContents(filepath string, expected []byte, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := fileShouldHaveContents(filepath, expected); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// FileSizeGreaterThan checks if a file on disk at a given filepath is larger than a given number of bytes.
+func (o *Optional) FileSizeGreaterThan(filepath string, size int64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := fileShouldHaveSizeGreaterThan(filepath, size); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Contains checks if a substring is present in a corpus.
+func (o *Optional) Contains(corpus, substring string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldContain(corpus, substring); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotContains checks if a substring is not present in a corpus.
+func (o *Optional) NotContains(corpus, substring string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotContain(corpus, substring); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// ContainsFold returns if a substring is present in a corpus, under Unicode case folding.
+func (o *Optional) ContainsFold(corpus, substring string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldContainFold(corpus, substring); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NotContainsFold returns if a substring is not present in a corpus, under Unicode case folding.
+func (o *Optional) NotContainsFold(corpus, substring string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNotContainFold(corpus, substring); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// HasPrefix returns if a corpus starts with a prefix.
+func (o *Optional) HasPrefix(corpus, prefix string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHavePrefix(corpus, prefix); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// HasSuffix returns if a corpus ends with a suffix.
+func (o *Optional) HasSuffix(corpus, suffix string, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldHaveSuffix(corpus, suffix); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AnyIndexed applies a predicate that also receives each element's index.
+func (o *Optional) AnyIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAnyIndexed(target, predicate); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Any applies a predicate.
+func (o *Optional) Any(target interface{}, predicate Predicate, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAny(target, predicate); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AnyOfInt applies a predicate.
+func (o *Optional) AnyOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAnyOfInt(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AnyOfInt64 applies a predicate.
+func (o *Optional) AnyOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAnyOfInt64(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AnyOfUint applies a predicate.
+func (o *Optional) AnyOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAnyOfUint(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AnyOfFloat applies a predicate.
+func (o *Optional) AnyOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAnyOfFloat(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AnyOfString applies a predicate.
+func (o *Optional) AnyOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAnyOfString(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// UniqueBy returns if the elements of a slice or array are distinct by a key,
+// i.e. that no two elements share the key returned by `key`.
+func (o *Optional) UniqueBy(target interface{}, key func(interface{}) interface{}, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldBeUniqueBy(target, key); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AllIndexed applies a predicate that also receives each element's index.
+func (o *Optional) AllIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAllIndexed(target, predicate); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// All applies a predicate.
+func (o *Optional) All(target interface{}, predicate Predicate, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAll(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AllOfInt applies a predicate.
+func (o *Optional) AllOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAllOfInt(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AllOfInt64 applies a predicate.
+func (o *Optional) AllOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAllOfInt64(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AllOfUint applies a predicate.
+func (o *Optional) AllOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAllOfUint(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AllOfFloat applies a predicate.
+func (o *Optional) AllOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAllOfFloat(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// AllOfString applies a predicate.
+func (o *Optional) AllOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldAllOfString(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NoneIndexed applies a predicate that also receives each element's index.
+func (o *Optional) NoneIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNoneIndexed(target, predicate); didFail {
+		o.fail(prefixOptional(message), userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// None applies a predicate.
+func (o *Optional) None(target interface{}, predicate Predicate, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNone(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NoneOfInt applies a predicate.
+func (o *Optional) NoneOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNoneOfInt(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NoneOfInt64 applies a predicate.
+func (o *Optional) NoneOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNoneOfInt64(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NoneOfUint applies a predicate.
+func (o *Optional) NoneOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNoneOfUint(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NoneOfFloat applies a predicate.
+func (o *Optional) NoneOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNoneOfFloat(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// NoneOfString applies a predicate.
+func (o *Optional) NoneOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) bool {
+	o.assertion()
+	if didFail, message := shouldNoneOfString(target, predicate); didFail {
+		o.fail(message, userMessageComponents...)
+		return false
+	}
+	return true
+}
+
+// Fail manually injects a failure.
+func (o *Optional) Fail(userMessageComponents ...interface{}) {
+	o.fail(prefixOptional("Assertion Failed"), userMessageComponents...)
+}
+
+// --------------------------------------------------------------------------------
+// OUTPUT
+// --------------------------------------------------------------------------------
+
+// failSettings are the test, output and formatting settings a failure is written with.
+type failSettings struct {
+	output    io.Writer
+	t         *testing.T
+	useColor  bool
+	format    OutputFormat
+	caller    callerOptions
+	formatter FailFormatter
+}
+
+func failNow(settings failSettings, message string, userMessageComponents ...interface{}) {
+	incrementFatal()
+	fail(settings, true, message, userMessageComponents...)
+	if settings.t != nil {
+		settings.t.FailNow()
+	} else {
+		panic(fmt.Errorf("%s", message))
+	}
+}
+
+func failPanic(settings failSettings, message string, userMessageComponents ...interface{}) {
+	incrementFatal()
+	fail(settings, true, message, userMessageComponents...)
+	panic(failureError(message, userMessageComponents...))
+}
+
+// failureError returns an error for a failure message without ansi color codes, followed by the user message if one is given.
+func failureError(message string, userMessageComponents ...interface{}) error {
+	if userMessage := fmt.Sprint(userMessageComponents...); len(userMessage) > 0 {
+		return fmt.Errorf("%s: %s", stripColor(message), userMessage)
+	}
+	return fmt.Errorf("%s", stripColor(message))
+}
+
+// fail writes a failure to a test and an output writer.
+// If the format is `FormatJSON`, the failure is written to the output writer as a json line.
+func fail(settings failSettings, fatal bool, message string, userMessageComponents ...interface{}) {
+	incrementFailed()
+	w, t := settings.output, settings.t
+	callers := callerInfo(settings.caller)
+	if settings.format == FormatJSON {
+		if w != nil {
+			writeJSONFailure(w, fatal, callers, message, userMessageComponents...)
+		}
+		w = nil
+	}
+	errorTrace := strings.Join(callers, "\n\t")
+
+	if len(errorTrace) == 0 {
+		errorTrace = "Unknown"
+	}
+
+	if settings.formatter != nil {
+		failFormatted(w, t, settings.formatter, callers, fatal, message, userMessageComponents...)
+		return
+	}
+
+	colorize := color
+	if !settings.useColor {
+		colorize = noColor
+		message = stripColor(message)
+	}
+
+	assertionFailedLabel := colorize("Assertion Failed!", RED)
+	locationLabel := colorize("Assert Location", GRAY)
+	assertionLabel := colorize("Assertion", GRAY)
+	messageLabel := colorize("Message", GRAY)
+
+	erasure := fmt.Sprintf("\r%s", getClearString())
+	userMessage := fmt.Sprint(userMessageComponents...)
+
+	if len(userMessage) > 0 {
+		errorFormat := `%s
+%s
 %s:
 	%s
 %s: 
@@ -751,159 +2238,1105 @@ func fail(w io.Writer, t *testing.T, message string, userMessageComponents ...in
 %s: 
 	%s
 
-`
-		if t != nil {
-			t.Errorf(errorFormat, erasure, assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message, messageLabel, userMessage)
+`
+		if t != nil {
+			t.Errorf(errorFormat, erasure, assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message, messageLabel, userMessage)
+		}
+		if w != nil {
+			fmt.Fprintf(w, errorFormat, "", assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message, messageLabel, userMessage)
+		}
+
+		return
+
+	}
+	errorFormat := `%s
+%s
+%s: 
+	%s
+%s: 
+	%s
+
+`
+	if t != nil {
+		t.Errorf(errorFormat, erasure, assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message)
+	}
+	if w != nil {
+		fmt.Fprintf(w, errorFormat, "", assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message)
+	}
+
+}
+
+// failFormatted writes a failure formatted by a fail formatter to a test and an output writer.
+func failFormatted(w io.Writer, t *testing.T, formatter FailFormatter, callers []string, fatal bool, message string, userMessageComponents ...interface{}) {
+	location := strings.Join(callers, ", ")
+	if len(location) == 0 {
+		location = "Unknown"
+	}
+	formatted := formatter(location, stripColor(message), fmt.Sprint(userMessageComponents...), fatal)
+	if t != nil {
+		t.Error(formatted)
+	}
+	if w != nil {
+		fmt.Fprint(w, formatted)
+	}
+}
+
+// optionalReport formats a summary of the checks and failures recorded by an optional.
+func optionalReport(checks int, failures []OptionalFailure, useColor bool) string {
+	colorize := color
+	if !useColor {
+		colorize = noColor
+	}
+
+	reportLabel := colorize("Assertion Report", GRAY)
+	if len(failures) > 0 {
+		reportLabel = colorize("Assertion Report", RED)
+	}
+	messageLabel := colorize("Message", GRAY)
+
+	report := bytes.NewBuffer(nil)
+	fmt.Fprintf(report, "%s: %d checks, %d failed\n", reportLabel, checks, len(failures))
+	for index, failure := range failures {
+		message := failure.Message
+		if !useColor {
+			message = stripColor(message)
+		}
+		message = strings.Replace(message, "\n", "\n\t\t", -1)
+		fmt.Fprintf(report, "\t%d) %s\n\t\t%s\n", index+1, failure.Location, message)
+		if len(failure.UserMessage) > 0 {
+			fmt.Fprintf(report, "\t\t%s: %s\n", messageLabel, failure.UserMessage)
+		}
+	}
+	return report.String()
+}
+
+// --------------------------------------------------------------------------------
+// ASSERTION LOGIC
+// --------------------------------------------------------------------------------
+
+func shouldHaveLength(collection interface{}, length int) (bool, string) {
+	if l, _ := getLength(collection); l != length {
+		message := shouldBeMultipleMessage(length, l, "Collection should have length")
+		return true, message
+	}
+	return false, EMPTY
+}
+
+func shouldHaveLengthAtLeast(collection interface{}, min int) (bool, string) {
+	l, ok := getLength(collection)
+	if !ok {
+		return true, fmt.Sprintf("Should be a collection with a length, actual type: %T", collection)
+	}
+	if l < min {
+		return true, fmt.Sprintf("Collection should have length at least %d, actual: %d", min, l)
+	}
+	return false, EMPTY
+}
+
+func shouldHaveLengthAtMost(collection interface{}, max int) (bool, string) {
+	l, ok := getLength(collection)
+	if !ok {
+		return true, fmt.Sprintf("Should be a collection with a length, actual type: %T", collection)
+	}
+	if l > max {
+		return true, fmt.Sprintf("Collection should have length at most %d, actual: %d", max, l)
+	}
+	return false, EMPTY
+}
+
+func shouldHaveChannelLength(ch interface{}, length int) (bool, string) {
+	if ch == nil {
+		return true, "Channel should not be nil"
+	}
+	chValue := reflect.ValueOf(ch)
+	if chValue.Kind() != reflect.Chan {
+		return true, fmt.Sprintf("Should be a channel, actual type: %T", ch)
+	}
+	if chValue.IsNil() {
+		return true, "Channel should not be nil"
+	}
+	if l := chValue.Len(); l != length {
+		message := shouldBeMultipleMessage(length, l, "Channel should have length")
+		return true, message
+	}
+	return false, EMPTY
+}
+
+// receivableChannel returns the reflect value of a channel that can be received from,
+// or a failure message if it cannot.
+func receivableChannel(ch interface{}) (reflect.Value, string) {
+	if ch == nil {
+		return reflect.Value{}, "Channel should not be nil"
+	}
+	chValue := reflect.ValueOf(ch)
+	if chValue.Kind() != reflect.Chan {
+		return reflect.Value{}, fmt.Sprintf("Should be a channel, actual type: %T", ch)
+	}
+	if chValue.Type().ChanDir()&reflect.RecvDir == 0 {
+		return reflect.Value{}, fmt.Sprintf("Channel should be able to receive, actual type: %T", ch)
+	}
+	return chValue, EMPTY
+}
+
+// receiveWithin waits for a receive on a channel for a given timeout.
+func receiveWithin(ch interface{}, timeout time.Duration) (value reflect.Value, received, closed bool, message string) {
+	var chValue reflect.Value
+	if chValue, message = receivableChannel(ch); message != EMPTY {
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	chosen, recv, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: chValue},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+	})
+	if chosen == 0 {
+		value, received, closed = recv, ok, !ok
+	}
+	return
+}
+
+func shouldReceiveWithin(ch interface{}, timeout time.Duration) (interface{}, bool, string) {
+	value, received, closed, message := receiveWithin(ch, timeout)
+	if message != EMPTY {
+		return nil, true, message
+	}
+	if closed {
+		return nil, true, "Channel closed"
+	}
+	if !received {
+		return nil, true, fmt.Sprintf("Channel of %v did not receive a value within %v", reflect.TypeOf(ch).Elem(), timeout)
+	}
+	return value.Interface(), false, EMPTY
+}
+
+func shouldNotReceiveWithin(ch interface{}, timeout time.Duration) (bool, string) {
+	value, received, closed, message := receiveWithin(ch, timeout)
+	if message != EMPTY {
+		return true, message
+	}
+	if closed {
+		return true, "Channel closed"
+	}
+	if received {
+		return true, shouldBeMessage(value.Interface(), "Channel should not have received a value")
+	}
+	return false, EMPTY
+}
+
+func shouldBeClosedWithin(ch interface{}, timeout time.Duration) (bool, string) {
+	value, received, closed, message := receiveWithin(ch, timeout)
+	if message != EMPTY {
+		return true, message
+	}
+	if closed {
+		return false, EMPTY
+	}
+	if received {
+		return true, shouldBeMessage(value.Interface(), "Channel received a value but was not closed")
+	}
+	return true, fmt.Sprintf("Timed out waiting %v for channel to close", timeout)
+}
+
+func shouldNotBeClosed(ch interface{}) (bool, string) {
+	chValue, message := receivableChannel(ch)
+	if message != EMPTY {
+		return true, message
+	}
+	chosen, _, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: chValue},
+		{Dir: reflect.SelectDefault},
+	})
+	if chosen == 0 && !ok {
+		return true, "Channel should not be closed"
+	}
+	return false, EMPTY
+}
+
+func shouldMapContain(superset, expectedEntries interface{}) (bool, string) {
+	supersetValue := reflect.ValueOf(superset)
+	if supersetValue.Kind() != reflect.Map {
+		return true, fmt.Sprintf("Should be a map, actual type: %T", superset)
+	}
+	expectedValue := reflect.ValueOf(expectedEntries)
+	if expectedValue.Kind() != reflect.Map {
+		return true, fmt.Sprintf("Expected entries should be a map, actual type: %T", expectedEntries)
+	}
+
+	keyType := supersetValue.Type().Key()
+	var missing, mismatched []string
+	for _, key := range expectedValue.MapKeys() {
+		if !key.Type().ConvertibleTo(keyType) {
+			missing = append(missing, fmt.Sprintf("%#v", key.Interface()))
+			continue
+		}
+		actual := supersetValue.MapIndex(key.Convert(keyType))
+		if !actual.IsValid() {
+			missing = append(missing, fmt.Sprintf("%#v", key.Interface()))
+			continue
+		}
+		expected := expectedValue.MapIndex(key)
+		if !areEqual(expected.Interface(), actual.Interface()) {
+			mismatched = append(mismatched, fmt.Sprintf("%#v: expected: %#v, actual: %#v", key.Interface(), expected.Interface(), actual.Interface()))
+		}
+	}
+	if len(missing) == 0 && len(mismatched) == 0 {
+		return false, EMPTY
+	}
+
+	sort.Strings(missing)
+	sort.Strings(mismatched)
+	var messages []string
+	if len(missing) > 0 {
+		messages = append(messages, fmt.Sprintf("Map should contain keys: %s", strings.Join(missing, ", ")))
+	}
+	if len(mismatched) > 0 {
+		messages = append(messages, fmt.Sprintf("Map values should be equal:\n\t%s", strings.Join(mismatched, "\n\t")))
+	}
+	return true, strings.Join(messages, "\n")
+}
+
+func shouldNotBeEmpty(collection interface{}) (bool, string) {
+	if l, _ := getLength(collection); l == 0 {
+		message := "Should not be empty"
+		return true, message
+	}
+	return false, EMPTY
+}
+
+func shouldBeEmpty(collection interface{}) (bool, string) {
+	if l, _ := getLength(collection); l != 0 {
+		message := shouldBeMessage(collection, "Should be empty")
+		return true, message
+	}
+	return false, EMPTY
+}
+
+func shouldBeValidUTF8(b []byte) (bool, string) {
+	for offset := 0; offset < len(b); {
+		r, size := utf8.DecodeRune(b[offset:])
+		if r == utf8.RuneError && size <= 1 {
+			return true, fmt.Sprintf("Should be valid UTF-8, invalid sequence at byte offset %d", offset)
+		}
+		offset += size
+	}
+	return false, EMPTY
+}
+
+func shouldBeASCIIOnly(b []byte) (bool, string) {
+	for offset, value := range b {
+		if value > unicode.MaxASCII {
+			return true, fmt.Sprintf("Should only contain ASCII, non-ASCII byte 0x%02x at byte offset %d", value, offset)
+		}
+	}
+	return false, EMPTY
+}
+
+func shouldBeBlank(value string) (bool, string) {
+	if strings.TrimSpace(value) != "" {
+		return true, fmt.Sprintf("Should be blank, actual: %q", value)
+	}
+	return false, EMPTY
+}
+
+func shouldNotBeBlank(value string) (bool, string) {
+	if strings.TrimSpace(value) == "" {
+		return true, fmt.Sprintf("Should not be blank, actual: %q", value)
+	}
+	return false, EMPTY
+}
+
+func shouldBeEqual(expected, actual interface{}) (bool, string) {
+	if !areEqual(expected, actual) {
+		return true, equalMessage(expected, actual)
+	}
+	return false, EMPTY
+}
+
+func shouldBeEqualValues(expected, actual interface{}) (bool, string) {
+	if !areEqualValues(expected, actual) {
+		return true, equalMessage(expected, actual)
+	}
+	return false, EMPTY
+}
+
+func shouldBeExactEqual(expected, actual interface{}) (bool, string) {
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		return true, shouldBeMultipleMessage(expected, actual, fmt.Sprintf("Objects should be exactly equal, types differ: %s vs %s", reflectTypeName(expected), reflectTypeName(actual)))
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		return true, shouldBeMultipleMessage(expected, actual, "Objects should be exactly equal")
+	}
+	return false, EMPTY
+}
+
+func shouldBeReferenceEqual(expected, actual interface{}) (bool, string) {
+	if !areReferenceEqual(expected, actual) {
+		return true, referenceEqualMessage(expected, actual)
+	}
+	return false, EMPTY
+}
+
+func shouldBePanicEqual(expected interface{}, action func()) (bool, string) {
+	var actual interface{}
+	var didPanic bool
+	var stack []byte
+	func() {
+		defer func() {
+			actual = recover()
+			didPanic = actual != nil
+			// the stack is only captured on failure, while it still includes the panic's origin.
+			if didPanic && !areEqual(expected, actual) {
+				stack = debug.Stack()
+			}
+		}()
+		action()
+	}()
+
+	if !didPanic {
+		return true, panicEqualMessage(didPanic, expected, actual)
+	}
+	if stack != nil {
+		return true, fmt.Sprintf("%s\n%s:\n%s", panicEqualMessage(didPanic, expected, actual), color("Stack", WHITE), trimAssertFrames(stack))
+	}
+	return false, EMPTY
+}
+
+func shouldPanicStackContain(substring string, action func()) (bool, string) {
+	var recovered interface{}
+	var stack []byte
+	func() {
+		defer func() {
+			if recovered = recover(); recovered != nil {
+				// deferred functions run before the stack unwinds, so it still includes the panic's origin.
+				stack = debug.Stack()
+			}
+		}()
+		action()
+	}()
+
+	if recovered == nil {
+		return true, "Should have produced a panic"
+	}
+	if !strings.Contains(string(stack), substring) {
+		return true, fmt.Sprintf("Panic stack should contain `%s`, panic: %v\n%s:\n%s", substring, recovered, color("Stack", WHITE), stack)
+	}
+	return false, EMPTY
+}
+
+func shouldNotBeEqual(expected, actual interface{}) (bool, string) {
+	if areEqual(expected, actual) {
+		return true, notEqualMessage(expected, actual)
+	}
+	return false, EMPTY
+}
+
+func shouldBeType(expected, actual interface{}) (bool, string) {
+	if expected == nil {
+		return true, "Expected type exemplar should not be nil"
+	}
+	if actual == nil {
+		return true, fmt.Sprintf("actual is nil, expected type %s", reflectTypeName(expected))
+	}
+	if expectedType, actualType := reflect.TypeOf(expected), reflect.TypeOf(actual); expectedType != actualType {
+		return true, fmt.Sprintf("Should be of type %s, actual type: %s", typeName(expectedType), typeName(actualType))
+	}
+	return false, EMPTY
+}
+
+func shouldImplement(interfaceObject, actual interface{}) (bool, string) {
+	interfaceType := reflect.TypeOf(interfaceObject)
+	if interfaceType == nil || interfaceType.Kind() != reflect.Ptr || interfaceType.Elem().Kind() != reflect.Interface {
+		return true, fmt.Sprintf("Interface should be given as a pointer to an interface, e.g. (*io.Reader)(nil), actual type: %s", reflectTypeName(interfaceObject))
+	}
+	interfaceType = interfaceType.Elem()
+	if actual == nil {
+		return true, fmt.Sprintf("actual is nil, expected type %s", typeName(interfaceType))
+	}
+	if actualType := reflect.TypeOf(actual); !actualType.Implements(interfaceType) {
+		return true, fmt.Sprintf("Should implement %s, actual type: %s", typeName(interfaceType), typeName(actualType))
+	}
+	return false, EMPTY
+}
+
+func shouldNotBeNil(object interface{}) (bool, string) {
+	if isNil(object) {
+		return true, "Should not be nil"
+	}
+	return false, EMPTY
+}
+
+func shouldBeNil(object interface{}) (bool, string) {
+	if !isNil(object) {
+		return true, shouldBeMessage(object, "Should be nil")
+	}
+	return false, EMPTY
+}
+
+func shouldBeTrue(value bool) (bool, string) {
+	if !value {
+		return true, "Should be true"
+	}
+	return false, EMPTY
+}
+
+func shouldBeFalse(value bool) (bool, string) {
+	if value {
+		return true, "Should be false"
+	}
+	return false, EMPTY
+}
+
+func shouldBeZero(value interface{}) (bool, string) {
+	if !isZero(value) {
+		return true, shouldBeMessage(value, "Should be zero")
+	}
+	return false, EMPTY
+}
+
+func shouldBeNonZero(value interface{}) (bool, string) {
+	if isZero(value) {
+		return true, "Should be non-zero"
+	}
+	return false, EMPTY
+}
+
+func shouldHaveRequiredFieldsSet(obj interface{}, fields []string) (bool, string) {
+	var unset, unknown []string
+	for _, field := range fields {
+		value, err := resolveFieldPath(obj, field)
+		if err != nil {
+			// a field behind a nil value is unset, rather than unknown.
+			if err.isNil {
+				unset = append(unset, field)
+			} else {
+				unknown = append(unknown, field)
+			}
+			continue
 		}
-		if w != nil {
-			fmt.Fprintf(w, errorFormat, "", assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message, messageLabel, userMessage)
+		if isZeroValue(value) {
+			unset = append(unset, field)
 		}
+	}
+	var messages []string
+	if len(unset) > 0 {
+		messages = append(messages, fmt.Sprintf("Required fields should be set: %s", strings.Join(unset, ", ")))
+	}
+	if len(unknown) > 0 {
+		messages = append(messages, fmt.Sprintf("Required fields should exist on %T: %s", obj, strings.Join(unknown, ", ")))
+	}
+	if len(messages) > 0 {
+		return true, strings.Join(messages, "; ")
+	}
+	return false, EMPTY
+}
 
-		return
+func shouldHaveFieldEqual(object interface{}, path string, expected interface{}) (bool, string) {
+	value, err := resolveFieldPath(object, path)
+	if err != nil {
+		return true, err.message
+	}
+	if actual := value.Interface(); !areEqual(expected, actual) {
+		return true, shouldBeMultipleMessage(expected, actual, fmt.Sprintf("Field `%s` should be equal", path))
+	}
+	return false, EMPTY
+}
 
+func shouldHaveFieldNotZero(object interface{}, path string) (bool, string) {
+	value, err := resolveFieldPath(object, path)
+	if err != nil {
+		return true, err.message
 	}
-	errorFormat := `%s
-%s
-%s: 
-	%s
-%s: 
-	%s
+	if isZeroValue(value) {
+		return true, fmt.Sprintf("Field `%s` should be non-zero", path)
+	}
+	return false, EMPTY
+}
 
-`
-	if t != nil {
-		t.Errorf(errorFormat, erasure, assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message)
+// resolveFieldPath returns the value at a path like `user.addresses[0].zip` in an object,
+// resolving struct fields (by name or json tag), string keyed map entries and slice or array indices.
+// If the path cannot be resolved, it returns an error with the part of the path that resolved,
+// and the type where resolution stopped.
+func resolveFieldPath(object interface{}, path string) (reflect.Value, *fieldPathError) {
+	segments, ok := parseFieldPath(path)
+	if !ok {
+		return reflect.Value{}, &fieldPathError{message: fmt.Sprintf("Field path `%s` is invalid", path)}
 	}
-	if w != nil {
-		fmt.Fprintf(w, errorFormat, "", assertionFailedLabel, locationLabel, errorTrace, assertionLabel, message)
+
+	value := reflect.ValueOf(object)
+	var resolved string
+	stopped := func(format string, args ...interface{}) (reflect.Value, *fieldPathError) {
+		stoppedPath, stoppedType := resolved, "<nil>"
+		if len(stoppedPath) == 0 {
+			stoppedPath = "(root)"
+		}
+		if value.IsValid() {
+			stoppedType = typeName(value.Type())
+		}
+		return reflect.Value{}, &fieldPathError{
+			message: fmt.Sprintf("Field path `%s` resolved to `%s`, then %s on type: %s", path, stoppedPath, fmt.Sprintf(format, args...), stoppedType),
+		}
+	}
+	stoppedAtNil := func(segment fieldPathSegment) (reflect.Value, *fieldPathError) {
+		_, err := stopped("found nil before `%s`", segment.String())
+		err.isNil = true
+		return reflect.Value{}, err
+	}
+
+	for _, segment := range segments {
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return stoppedAtNil(segment)
+			}
+			value = value.Elem()
+		}
+		if !value.IsValid() {
+			return stoppedAtNil(segment)
+		}
+
+		if segment.isIndex {
+			if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+				return stopped("cannot index `%s`", segment.String())
+			}
+			if segment.index >= value.Len() {
+				return stopped("index %d is out of range with length %d", segment.index, value.Len())
+			}
+			value = value.Index(segment.index)
+		} else {
+			switch value.Kind() {
+			case reflect.Struct:
+				field, ok := fieldByNameOrTag(value, segment.name)
+				if !ok {
+					return stopped("no field `%s`", segment.name)
+				}
+				if !field.CanInterface() {
+					return stopped("field `%s` is unexported", segment.name)
+				}
+				value = field
+			case reflect.Map:
+				if value.Type().Key().Kind() != reflect.String {
+					return stopped("map keys are not strings for `%s`", segment.name)
+				}
+				entry := value.MapIndex(reflect.ValueOf(segment.name).Convert(value.Type().Key()))
+				if !entry.IsValid() {
+					return stopped("no key `%s`", segment.name)
+				}
+				value = entry
+			default:
+				return stopped("cannot get `%s`", segment.name)
+			}
+		}
+
+		resolved = joinFieldPath(resolved, segment)
+	}
+	// map entries and slice elements of interface types hold the actual value.
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
 	}
+	return value, nil
+}
 
+// fieldPathError is the reason a field path could not be resolved.
+type fieldPathError struct {
+	message string
+	// isNil is set if the path could not be resolved past a nil value.
+	isNil bool
 }
 
-// --------------------------------------------------------------------------------
-// ASSERTION LOGIC
-// --------------------------------------------------------------------------------
+// fieldPathSegment is a field name or an index in a field path.
+type fieldPathSegment struct {
+	name    string
+	index   int
+	isIndex bool
+}
 
-func shouldHaveLength(collection interface{}, length int) (bool, string) {
-	if l := getLength(collection); l != length {
-		message := shouldBeMultipleMessage(length, l, "Collection should have length")
+// String returns the segment as it appears in a path.
+func (fps fieldPathSegment) String() string {
+	if fps.isIndex {
+		return fmt.Sprintf("[%d]", fps.index)
+	}
+	return fps.name
+}
+
+// joinFieldPath appends a segment to a path.
+func joinFieldPath(path string, segment fieldPathSegment) string {
+	if segment.isIndex || len(path) == 0 {
+		return path + segment.String()
+	}
+	return path + "." + segment.String()
+}
+
+// parseFieldPath splits a path like `user.addresses[0].zip` into its names and indices.
+func parseFieldPath(path string) ([]fieldPathSegment, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+	var segments []fieldPathSegment
+	for _, part := range strings.Split(path, ".") {
+		name := part
+		var indices string
+		if bracket := strings.Index(part, "["); bracket >= 0 {
+			name, indices = part[:bracket], part[bracket:]
+		}
+		if len(name) > 0 {
+			segments = append(segments, fieldPathSegment{name: name})
+		} else if len(indices) == 0 || len(segments) > 0 {
+			return nil, false
+		}
+		for len(indices) > 0 {
+			end := strings.Index(indices, "]")
+			if indices[0] != '[' || end < 2 {
+				return nil, false
+			}
+			index, err := strconv.Atoi(indices[1:end])
+			if err != nil || index < 0 {
+				return nil, false
+			}
+			segments = append(segments, fieldPathSegment{index: index, isIndex: true})
+			indices = indices[end+1:]
+		}
+	}
+	return segments, true
+}
+
+// fieldByNameOrTag returns a struct field by its name, its json tag name, or its name ignoring case.
+func fieldByNameOrTag(value reflect.Value, name string) (reflect.Value, bool) {
+	if field := value.FieldByName(name); field.IsValid() {
+		return field, true
+	}
+	valueType := value.Type()
+	for index := 0; index < valueType.NumField(); index++ {
+		if tagName := strings.Split(valueType.Field(index).Tag.Get("json"), ",")[0]; tagName == name {
+			return value.Field(index), true
+		}
+	}
+	for index := 0; index < valueType.NumField(); index++ {
+		if strings.EqualFold(valueType.Field(index).Name, name) {
+			return value.Field(index), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// isZeroValue returns if a value is the zero value for its type.
+func isZeroValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return value.IsNil()
+	case reflect.Struct:
+		for index := 0; index < value.NumField(); index++ {
+			if !isZeroValue(value.Field(index)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Array:
+		for index := 0; index < value.Len(); index++ {
+			if !isZeroValue(value.Index(index)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.String:
+		return value.Len() == 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Complex64, reflect.Complex128:
+		return value.Complex() == 0
+	default:
+		return false
+	}
+}
+
+func fileShouldExist(filePath string) (bool, string) {
+	_, err := os.Stat(filePath)
+	if err != nil {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("File doesnt exist: %s, `pwd`: %s, error: %v", filePath, pwd, err)
+		return true, message
+	}
+	return false, EMPTY
+}
+
+func fileShouldNotExist(filePath string) (bool, string) {
+	_, err := os.Stat(filePath)
+	if err == nil {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("File exists: %s, `pwd`: %s", filePath, pwd)
+		return true, message
+	}
+	if !os.IsNotExist(err) {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("Unable to stat file: %s, `pwd`: %s, error: %v", filePath, pwd, err)
+		return true, message
+	}
+	return false, EMPTY
+}
+
+func dirShouldExist(dirPath string) (bool, string) {
+	info, err := os.Stat(dirPath)
+	if err != nil {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("Directory doesnt exist: %s, `pwd`: %s, error: %v", dirPath, pwd, err)
+		return true, message
+	}
+	if !info.IsDir() {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("Path exists but is a file, not a directory: %s, `pwd`: %s", dirPath, pwd)
+		return true, message
+	}
+	return false, EMPTY
+}
+
+func fileShouldContain(filePath, substring string) (bool, string) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("Unable to read file: %s, `pwd`: %s, error: %v", filePath, pwd, err)
+		return true, message
+	}
+	defer f.Close()
+
+	contents, err := ioutil.ReadAll(io.LimitReader(f, FileContainsMaxBytes+1))
+	if err != nil {
+		message := fmt.Sprintf("Unable to read file: %s, error: %v", filePath, err)
+		return true, message
+	}
+	if len(contents) > FileContainsMaxBytes {
+		message := fmt.Sprintf("File is larger than %d bytes: %s", FileContainsMaxBytes, filePath)
+		return true, message
+	}
+	if !strings.Contains(string(contents), substring) {
+		message := fmt.Sprintf("File `%s` does not contain `%s`", filePath, substring)
+		return true, message
+	}
+	return false, EMPTY
+}
+
+// fileShouldHaveContents compares a file to expected contents, reading the file in chunks
+// so that it stops at the first difference.
+func fileShouldHaveContents(filePath string, expected []byte) (bool, string) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("Unable to read file: %s, `pwd`: %s, error: %v", filePath, pwd, err)
+		return true, message
+	}
+	defer f.Close()
+
+	chunk := make([]byte, fileContentsChunkSize)
+	var offset int
+	for {
+		read, err := io.ReadFull(f, chunk)
+		for index, actualByte := range chunk[:read] {
+			if offset+index >= len(expected) {
+				message := fmt.Sprintf("File `%s` is longer than the expected %d bytes", filePath, len(expected))
+				return true, message
+			}
+			if actualByte != expected[offset+index] {
+				return true, fileContentsDifferenceMessage(filePath, offset+index, expected, chunk[index:read])
+			}
+		}
+		offset += read
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			message := fmt.Sprintf("Unable to read file: %s, error: %v", filePath, err)
+			return true, message
+		}
+	}
+	if offset < len(expected) {
+		message := fmt.Sprintf("File `%s` is shorter than expected; length: %d, expected length: %d", filePath, offset, len(expected))
+		return true, message
+	}
+	return false, EMPTY
+}
+
+// fileContentsDifferenceMessage describes the first difference between a file and its expected contents.
+func fileContentsDifferenceMessage(filePath string, offset int, expected, actual []byte) string {
+	line := bytes.Count(expected[:offset], []byte("\n")) + 1
+	expected = expected[offset:]
+	if len(expected) > fileContentsSnippetSize {
+		expected = expected[:fileContentsSnippetSize]
+	}
+	if len(actual) > fileContentsSnippetSize {
+		actual = actual[:fileContentsSnippetSize]
+	}
+	return fmt.Sprintf("File `%s` differs at byte %d (line %d)\n\t%s: %q\n\t%s: %q", filePath, offset, line, color("Expected", WHITE), expected, color("Actual", WHITE), actual)
+}
+
+func fileShouldHaveSizeGreaterThan(filePath string, size int64) (bool, string) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		pwd, _ := os.Getwd()
+		message := fmt.Sprintf("File doesnt exist: %s, `pwd`: %s, error: %v", filePath, pwd, err)
+		return true, message
+	}
+	if info.Size() <= size {
+		message := fmt.Sprintf("File `%s` should be larger than %d bytes, actual: %d bytes", filePath, size, info.Size())
+		return true, message
+	}
+	return false, EMPTY
+}
+
+func shouldBeInDelta(from, to, delta float64) (bool, string) {
+	// comparisons with NaN are always false, so NaN would otherwise always be in delta.
+	if math.IsNaN(from) || math.IsNaN(to) || math.IsNaN(delta) {
+		return true, fmt.Sprintf("Absolute difference of %v and %v should be less than %v, but NaN is never in delta", from, to, delta)
+	}
+	diff := math.Abs(from - to)
+	if diff > delta {
+		message := fmt.Sprintf("Absolute difference of %0.5f and %0.5f should be less than %0.5f", from, to, delta)
 		return true, message
 	}
 	return false, EMPTY
 }
 
-func shouldNotBeEmpty(collection interface{}) (bool, string) {
-	if l := getLength(collection); l == 0 {
-		message := "Should not be empty"
-		return true, message
+func shouldBeMapInDelta(expected, actual map[string]float64, delta float64) (bool, string) {
+	var missing, unexpected, outside []string
+	for key, expectedValue := range expected {
+		actualValue, ok := actual[key]
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		if didFail, _ := shouldBeInDelta(expectedValue, actualValue, delta); didFail {
+			outside = append(outside, fmt.Sprintf("%s (expected: %v, actual: %v)", key, expectedValue, actualValue))
+		}
+	}
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			unexpected = append(unexpected, key)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	sort.Strings(outside)
+
+	var messages []string
+	if len(missing) > 0 {
+		messages = append(messages, fmt.Sprintf("Map should have keys: %s", strings.Join(missing, ", ")))
+	}
+	if len(unexpected) > 0 {
+		messages = append(messages, fmt.Sprintf("Map should not have keys: %s", strings.Join(unexpected, ", ")))
+	}
+	if len(outside) > 0 {
+		messages = append(messages, fmt.Sprintf("Map values should be within %v: %s", delta, strings.Join(outside, ", ")))
+	}
+	if len(messages) > 0 {
+		return true, strings.Join(messages, "; ")
+	}
+	return false, EMPTY
+}
+
+func shouldNotBeInDelta(from, to, delta float64) (bool, string) {
+	if math.IsNaN(from) || math.IsNaN(to) || math.IsNaN(delta) {
+		return true, fmt.Sprintf("Absolute difference of %v and %v should be greater than %v, but NaN is never outside of delta", from, to, delta)
+	}
+	diff := math.Abs(from - to)
+	if diff <= delta {
+		return true, fmt.Sprintf("Absolute difference of %0.5f and %0.5f should be greater than %0.5f, actual: %0.5f", from, to, delta, diff)
+	}
+	return false, EMPTY
+}
+
+func shouldBeInEpsilon(expected, actual, epsilon float64) (bool, string) {
+	if math.IsNaN(expected) || math.IsNaN(actual) || math.IsNaN(epsilon) {
+		return true, fmt.Sprintf("Relative error of %v and %v should be at most %v, but NaN is never in epsilon", expected, actual, epsilon)
+	}
+	if math.IsInf(expected, 0) || math.IsInf(actual, 0) {
+		if expected != actual {
+			return true, fmt.Sprintf("Relative error of %v and %v should be at most %v, but the relative error of an infinity is only defined for equal infinities", expected, actual, epsilon)
+		}
+		return false, EMPTY
+	}
+	if expected == 0 {
+		if difference := math.Abs(actual); difference > epsilon {
+			return true, fmt.Sprintf("Absolute difference of %v and %v should be at most %v when expected is 0, actual: %v", expected, actual, epsilon, difference)
+		}
+		return false, EMPTY
+	}
+	if relativeError := math.Abs(expected-actual) / math.Abs(expected); relativeError > epsilon {
+		return true, fmt.Sprintf("Relative error of %v and %v should be at most %v, actual: %v", expected, actual, epsilon, relativeError)
+	}
+	return false, EMPTY
+}
+
+func shouldBeWithinPercent(actual, expected, percent float64) (bool, string) {
+	if expected == 0 {
+		if actual != 0 {
+			return true, fmt.Sprintf("%v should be within %v%% of 0, but only 0 is within a percentage of 0", actual, percent)
+		}
+		return false, EMPTY
+	}
+	difference := math.Abs(actual-expected) / math.Abs(expected) * 100
+	if difference > percent {
+		return true, fmt.Sprintf("%v should be within %v%% of %v, actual difference: %0.2f%%", actual, percent, expected, difference)
 	}
 	return false, EMPTY
 }
 
-func shouldBeEmpty(collection interface{}) (bool, string) {
-	if l := getLength(collection); l != 0 {
-		message := shouldBeMessage(collection, "Should be empty")
-		return true, message
+func shouldBePositive(value interface{}) (bool, string) {
+	sign, isNumber := numberSign(value)
+	if !isNumber {
+		return true, fmt.Sprintf("Should be positive, but %v is not a number", value)
+	}
+	if sign == 0 {
+		return true, fmt.Sprintf("Should be positive, actual: %v (zero is neither positive nor negative)", value)
+	}
+	if sign < 0 {
+		return true, fmt.Sprintf("Should be positive, actual: %v", value)
 	}
 	return false, EMPTY
 }
 
-func shouldBeEqual(expected, actual interface{}) (bool, string) {
-	if !areEqual(expected, actual) {
-		return true, equalMessage(expected, actual)
+func shouldBeNegative(value interface{}) (bool, string) {
+	sign, isNumber := numberSign(value)
+	if !isNumber {
+		return true, fmt.Sprintf("Should be negative, but %v is not a number", value)
+	}
+	if isUintKind(reflect.ValueOf(value).Kind()) {
+		return true, fmt.Sprintf("Should be negative, but %v is an unsigned %T, which cannot be negative", value, value)
+	}
+	if sign == 0 {
+		return true, fmt.Sprintf("Should be negative, actual: %v (zero is neither positive nor negative)", value)
+	}
+	if sign > 0 {
+		return true, fmt.Sprintf("Should be negative, actual: %v", value)
 	}
 	return false, EMPTY
 }
 
-func shouldBeReferenceEqual(expected, actual interface{}) (bool, string) {
-	if !areReferenceEqual(expected, actual) {
-		return true, referenceEqualMessage(expected, actual)
+// numberSign returns the sign of a number as -1, 0 or 1, and if the value is a number.
+// NaN has a sign of zero.
+func numberSign(value interface{}) (int, bool) {
+	if value == nil {
+		return 0, false
+	}
+	reflectValue := reflect.ValueOf(value)
+	switch {
+	case !isNumberKind(reflectValue.Kind()):
+		return 0, false
+	case isFloatKind(reflectValue.Kind()):
+		switch floatValue := reflectValue.Float(); {
+		case floatValue > 0:
+			return 1, true
+		case floatValue < 0:
+			return -1, true
+		default:
+			return 0, true
+		}
+	case isUintKind(reflectValue.Kind()):
+		if reflectValue.Uint() > 0 {
+			return 1, true
+		}
+		return 0, true
+	default:
+		switch intValue := reflectValue.Int(); {
+		case intValue > 0:
+			return 1, true
+		case intValue < 0:
+			return -1, true
+		default:
+			return 0, true
+		}
 	}
-	return false, EMPTY
 }
 
-func shouldBePanicEqual(expected interface{}, action func()) (bool, string) {
-	var actual interface{}
-	var didPanic bool
-	func() {
-		defer func() {
-			actual = recover()
-			didPanic = actual != nil
-		}()
-		action()
-	}()
-
-	if !didPanic || (didPanic && !areEqual(expected, actual)) {
-		return true, panicEqualMessage(didPanic, expected, actual)
+func shouldBeTimeBefore(t1, t2 time.Time) (bool, string) {
+	if !t1.Before(t2) {
+		return true, timeComparisonMessage(t1, t2, "should be before")
 	}
 	return false, EMPTY
 }
 
-func shouldNotBeEqual(expected, actual interface{}) (bool, string) {
-	if areEqual(expected, actual) {
-		return true, notEqualMessage(expected, actual)
+func shouldBeTimeAfter(t1, t2 time.Time) (bool, string) {
+	if !t1.After(t2) {
+		return true, timeComparisonMessage(t1, t2, "should be after")
 	}
 	return false, EMPTY
 }
 
-func shouldNotBeNil(object interface{}) (bool, string) {
-	if isNil(object) {
-		return true, "Should not be nil"
+func shouldBeTimeEqual(t1, t2 time.Time) (bool, string) {
+	if !t1.Equal(t2) {
+		return true, timeComparisonMessage(t1, t2, "should equal")
 	}
 	return false, EMPTY
 }
 
-func shouldBeNil(object interface{}) (bool, string) {
-	if !isNil(object) {
-		return true, shouldBeMessage(object, "Should be nil")
+// timeComparisonMessage returns a message for a failed time comparison, with the signed difference of the times.
+func timeComparisonMessage(t1, t2 time.Time, comparison string) string {
+	return fmt.Sprintf("%s %s %s, difference: %v", t1.Format(time.RFC3339Nano), comparison, t2.Format(time.RFC3339Nano), t1.Sub(t2))
+}
+
+func shouldBeSameDay(t0, t1 time.Time, location *time.Location) (bool, string) {
+	if location == nil {
+		location = sameDayLocation(t0, t1)
+	}
+	y0, m0, d0 := t0.In(location).Date()
+	y1, m1, d1 := t1.In(location).Date()
+	if y0 != y1 || m0 != m1 || d0 != d1 {
+		return true, fmt.Sprintf("%s and %s should be on the same day in %s, actual: %04d-%02d-%02d and %04d-%02d-%02d",
+			t0.Format(time.RFC3339), t1.Format(time.RFC3339), location, y0, m0, d0, y1, m1, d1)
 	}
 	return false, EMPTY
 }
 
-func shouldBeTrue(value bool) (bool, string) {
-	if !value {
-		return true, "Should be true"
+// sameDayLocation returns the location two times are compared in by `SameDay`;
+// the times' location if they have the same one, otherwise UTC.
+func sameDayLocation(t0, t1 time.Time) *time.Location {
+	if t0.Location().String() == t1.Location().String() {
+		return t0.Location()
 	}
-	return false, EMPTY
+	return time.UTC
 }
 
-func shouldBeFalse(value bool) (bool, string) {
-	if value {
-		return true, "Should be false"
+func shouldBeNaN(f float64) (bool, string) {
+	if !math.IsNaN(f) {
+		return true, fmt.Sprintf("Should be NaN, actual: %v", f)
 	}
 	return false, EMPTY
 }
 
-func shouldBeZero(value interface{}) (bool, string) {
-	if !isZero(value) {
-		return true, shouldBeMessage(value, "Should be zero")
+func shouldNotBeNaN(f float64) (bool, string) {
+	if math.IsNaN(f) {
+		return true, "Should not be NaN"
 	}
 	return false, EMPTY
 }
 
-func shouldBeNonZero(value interface{}) (bool, string) {
-	if isZero(value) {
-		return true, "Should be non-zero"
+func shouldBeInf(f float64, sign int) (bool, string) {
+	if !math.IsInf(f, sign) {
+		return true, fmt.Sprintf("Should be %s, actual: %v", infinityName(sign), f)
 	}
 	return false, EMPTY
 }
 
-func fileShouldExist(filePath string) (bool, string) {
-	_, err := os.Stat(filePath)
-	if err != nil {
-		pwd, _ := os.Getwd()
-		message := fmt.Sprintf("File doesnt exist: %s, `pwd`: %s", filePath, pwd)
-		return true, message
+func shouldNotBeInf(f float64, sign int) (bool, string) {
+	if math.IsInf(f, sign) {
+		return true, fmt.Sprintf("Should not be %s, actual: %v", infinityName(sign), f)
 	}
 	return false, EMPTY
 }
 
-func shouldBeInDelta(from, to, delta float64) (bool, string) {
-	diff := math.Abs(from - to)
-	if diff > delta {
-		message := fmt.Sprintf("Absolute difference of %0.5f and %0.5f should be less than %0.5f", from, to, delta)
-		return true, message
+// infinityName returns the name of the infinity matched by a sign, as with `math.IsInf`.
+func infinityName(sign int) string {
+	switch {
+	case sign > 0:
+		return "positive infinity"
+	case sign < 0:
+		return "negative infinity"
+	default:
+		return "an infinity"
 	}
-	return false, EMPTY
 }
 
 func shouldBeInTimeDelta(from, to time.Time, delta time.Duration) (bool, string) {
@@ -936,6 +3369,55 @@ func shouldNotContain(corpus, subString string) (bool, string) {
 	return false, EMPTY
 }
 
+func shouldContainFold(corpus, subString string) (bool, string) {
+	if !containsFold(corpus, subString) {
+		return true, fmt.Sprintf("`%s` should contain `%s` ignoring case", corpus, subString)
+	}
+	return false, EMPTY
+}
+
+func shouldNotContainFold(corpus, subString string) (bool, string) {
+	if containsFold(corpus, subString) {
+		return true, fmt.Sprintf("`%s` should not contain `%s` ignoring case", corpus, subString)
+	}
+	return false, EMPTY
+}
+
+// containsFold returns if a substring is present in a corpus under Unicode case folding, as with `strings.EqualFold`.
+// Case folding maps runes one to one, so each candidate is the same number of runes as the substring.
+func containsFold(corpus, subString string) bool {
+	runes := utf8.RuneCountInString(subString)
+	for start := 0; ; {
+		end := start
+		for count := 0; count < runes && end < len(corpus); count++ {
+			_, size := utf8.DecodeRuneInString(corpus[end:])
+			end += size
+		}
+		if utf8.RuneCountInString(corpus[start:end]) < runes {
+			return false
+		}
+		if strings.EqualFold(corpus[start:end], subString) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(corpus[start:])
+		start += size
+	}
+}
+
+func shouldHavePrefix(corpus, prefix string) (bool, string) {
+	if !strings.HasPrefix(corpus, prefix) {
+		return true, fmt.Sprintf("`%s` should start with `%s`", corpus, prefix)
+	}
+	return false, EMPTY
+}
+
+func shouldHaveSuffix(corpus, suffix string) (bool, string) {
+	if !strings.HasSuffix(corpus, suffix) {
+		return true, fmt.Sprintf("`%s` should end with `%s`", corpus, suffix)
+	}
+	return false, EMPTY
+}
+
 func shouldAny(target interface{}, predicate Predicate) (bool, string) {
 	t := reflect.TypeOf(target)
 	for t.Kind() == reflect.Ptr {
@@ -960,6 +3442,19 @@ func shouldAny(target interface{}, predicate Predicate) (bool, string) {
 	return true, "Predicate did not fire for any element in target"
 }
 
+func shouldAnyIndexed(target interface{}, predicate PredicateIndexed) (bool, string) {
+	v, message := indexedTarget(target)
+	if message != EMPTY {
+		return true, message
+	}
+	for x := 0; x < v.Len(); x++ {
+		if predicate(x, v.Index(x).Interface()) {
+			return false, EMPTY
+		}
+	}
+	return true, "Predicate did not fire for any element in target"
+}
+
 func shouldAnyOfInt(target []int, predicate PredicateOfInt) (bool, string) {
 	v := reflect.ValueOf(target)
 
@@ -972,6 +3467,24 @@ func shouldAnyOfInt(target []int, predicate PredicateOfInt) (bool, string) {
 	return true, "Predicate did not fire for any element in target"
 }
 
+func shouldAnyOfInt64(target []int64, predicate PredicateOfInt64) (bool, string) {
+	for _, obj := range target {
+		if predicate(obj) {
+			return false, EMPTY
+		}
+	}
+	return true, "Predicate did not fire for any element in target"
+}
+
+func shouldAnyOfUint(target []uint, predicate PredicateOfUint) (bool, string) {
+	for _, obj := range target {
+		if predicate(obj) {
+			return false, EMPTY
+		}
+	}
+	return true, "Predicate did not fire for any element in target"
+}
+
 func shouldAnyOfFloat(target []float64, predicate PredicateOfFloat) (bool, string) {
 	v := reflect.ValueOf(target)
 
@@ -1020,6 +3533,20 @@ func shouldAll(target interface{}, predicate Predicate) (bool, string) {
 	return false, EMPTY
 }
 
+func shouldAllIndexed(target interface{}, predicate PredicateIndexed) (bool, string) {
+	v, message := indexedTarget(target)
+	if message != EMPTY {
+		return true, message
+	}
+	for x := 0; x < v.Len(); x++ {
+		obj := v.Index(x).Interface()
+		if !predicate(x, obj) {
+			return true, fmt.Sprintf("Predicate failed for element at index %d in target: %#v", x, obj)
+		}
+	}
+	return false, EMPTY
+}
+
 func shouldAllOfInt(target []int, predicate PredicateOfInt) (bool, string) {
 	v := reflect.ValueOf(target)
 
@@ -1032,6 +3559,24 @@ func shouldAllOfInt(target []int, predicate PredicateOfInt) (bool, string) {
 	return false, EMPTY
 }
 
+func shouldAllOfInt64(target []int64, predicate PredicateOfInt64) (bool, string) {
+	for _, obj := range target {
+		if !predicate(obj) {
+			return true, fmt.Sprintf("Predicate failed for element in target: %#v", obj)
+		}
+	}
+	return false, EMPTY
+}
+
+func shouldAllOfUint(target []uint, predicate PredicateOfUint) (bool, string) {
+	for _, obj := range target {
+		if !predicate(obj) {
+			return true, fmt.Sprintf("Predicate failed for element in target: %#v", obj)
+		}
+	}
+	return false, EMPTY
+}
+
 func shouldAllOfFloat(target []float64, predicate PredicateOfFloat) (bool, string) {
 	v := reflect.ValueOf(target)
 
@@ -1080,6 +3625,49 @@ func shouldNone(target interface{}, predicate Predicate) (bool, string) {
 	return false, EMPTY
 }
 
+func shouldNoneIndexed(target interface{}, predicate PredicateIndexed) (bool, string) {
+	v, message := indexedTarget(target)
+	if message != EMPTY {
+		return true, message
+	}
+	for x := 0; x < v.Len(); x++ {
+		obj := v.Index(x).Interface()
+		if predicate(x, obj) {
+			return true, fmt.Sprintf("Predicate passed for element at index %d in target: %#v", x, obj)
+		}
+	}
+	return false, EMPTY
+}
+
+// indexedTarget returns the slice or array value of a target, dereferencing pointers.
+func shouldBeUniqueBy(target interface{}, key func(interface{}) interface{}) (bool, string) {
+	v, message := indexedTarget(target)
+	if message != EMPTY {
+		return true, message
+	}
+	keys := make([]interface{}, v.Len())
+	for x := 0; x < v.Len(); x++ {
+		keys[x] = key(v.Index(x).Interface())
+		for y := 0; y < x; y++ {
+			if areEqual(keys[y], keys[x]) {
+				return true, fmt.Sprintf("Elements at indices %d and %d share the key: %#v", y, x, keys[x])
+			}
+		}
+	}
+	return false, EMPTY
+}
+
+func indexedTarget(target interface{}) (reflect.Value, string) {
+	v := reflect.ValueOf(target)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return reflect.Value{}, "`target` is not a slice"
+	}
+	return v, EMPTY
+}
+
 func shouldNoneOfInt(target []int, predicate PredicateOfInt) (bool, string) {
 	v := reflect.ValueOf(target)
 
@@ -1092,6 +3680,24 @@ func shouldNoneOfInt(target []int, predicate PredicateOfInt) (bool, string) {
 	return false, EMPTY
 }
 
+func shouldNoneOfInt64(target []int64, predicate PredicateOfInt64) (bool, string) {
+	for _, obj := range target {
+		if predicate(obj) {
+			return true, fmt.Sprintf("Predicate passed for element in target: %#v", obj)
+		}
+	}
+	return false, EMPTY
+}
+
+func shouldNoneOfUint(target []uint, predicate PredicateOfUint) (bool, string) {
+	for _, obj := range target {
+		if predicate(obj) {
+			return true, fmt.Sprintf("Predicate passed for element in target: %#v", obj)
+		}
+	}
+	return false, EMPTY
+}
+
 func shouldNoneOfFloat(target []float64, predicate PredicateOfFloat) (bool, string) {
 	v := reflect.ValueOf(target)
 
@@ -1151,6 +3757,33 @@ func referenceEqualMessage(expected, actual interface{}) string {
 	return shouldBeMultipleMessage(expected, actual, "References should be equal")
 }
 
+// assertPackage is the import path of this package, which prefixes the names of its functions in stack traces.
+var assertPackage = reflect.TypeOf(Assertions{}).PkgPath()
+
+// trimAssertFrames removes the frames of this package, other than its tests, and of `debug.Stack` from a stack trace.
+// Each frame in a stack trace is a function line followed by an indented file line.
+func trimAssertFrames(stack []byte) []byte {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+	output := make([]string, 0, len(lines))
+	for x := 0; x < len(lines); x++ {
+		line := lines[x]
+		if x+1 < len(lines) && strings.HasPrefix(lines[x+1], "\t") {
+			file := lines[x+1]
+			x++
+			if strings.HasPrefix(line, "runtime/debug.Stack(") {
+				continue
+			}
+			if strings.HasPrefix(line, assertPackage+".") && !strings.Contains(file, "_test.go:") {
+				continue
+			}
+			output = append(output, line, file)
+			continue
+		}
+		output = append(output, line)
+	}
+	return []byte(strings.Join(output, "\n"))
+}
+
 func panicEqualMessage(didPanic bool, expected, actual interface{}) string {
 	if !didPanic {
 		return "Should have produced a panic"
@@ -1158,24 +3791,60 @@ func panicEqualMessage(didPanic bool, expected, actual interface{}) string {
 	return shouldBeMultipleMessage(expected, actual, "Panic from action should equal")
 }
 
-func getLength(object interface{}) int {
+// getLength returns the length of a collection, and if the object has a length at all.
+// Collections behind pointers, e.g. `*[]int`, are dereferenced; nil pointers have zero length.
+func getLength(object interface{}) (int, bool) {
+	// fast paths for common types, which avoid reflection.
+	switch typed := object.(type) {
+	case nil:
+		return 0, true
+	case string:
+		return len(typed), true
+	case []byte:
+		return len(typed), true
+	case []int:
+		return len(typed), true
+	case []string:
+		return len(typed), true
+	case []interface{}:
+		return len(typed), true
+	case map[string]string:
+		return len(typed), true
+	case map[string]interface{}:
+		return len(typed), true
+	}
+	return getLengthReflect(object)
+}
+
+func getLengthReflect(object interface{}) (int, bool) {
 	if object == nil {
-		return 0
-	} else if object == "" {
-		return 0
+		return 0, true
 	}
 
 	objValue := reflect.ValueOf(object)
+	objType := objValue.Type()
+	for objType.Kind() == reflect.Ptr {
+		objType = objType.Elem()
+	}
+	if !hasLength(objType.Kind()) {
+		return 0, false
+	}
 
-	switch objValue.Kind() {
-	case reflect.Map:
-		fallthrough
-	case reflect.Slice, reflect.Chan, reflect.String:
-		{
-			return objValue.Len()
+	for objValue.Kind() == reflect.Ptr {
+		if objValue.IsNil() {
+			return 0, true
 		}
+		objValue = objValue.Elem()
+	}
+	return objValue.Len(), true
+}
+
+func hasLength(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Chan, reflect.String:
+		return true
 	}
-	return 0
+	return false
 }
 
 func isNil(object interface{}) bool {
@@ -1184,9 +3853,9 @@ func isNil(object interface{}) bool {
 	}
 
 	value := reflect.ValueOf(object)
-	kind := value.Kind()
-	if kind >= reflect.Chan && kind <= reflect.Slice && value.IsNil() {
-		return true
+	switch value.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return value.IsNil()
 	}
 	return false
 }
@@ -1207,6 +3876,46 @@ func areReferenceEqual(expected, actual interface{}) bool {
 }
 
 func areEqual(expected, actual interface{}) bool {
+	// fast paths for common types when both objects have the same type, which avoid reflection.
+	switch typedExpected := expected.(type) {
+	case int:
+		if typedActual, ok := actual.(int); ok {
+			return typedExpected == typedActual
+		}
+	case int64:
+		if typedActual, ok := actual.(int64); ok {
+			return typedExpected == typedActual
+		}
+	case string:
+		if typedActual, ok := actual.(string); ok {
+			return typedExpected == typedActual
+		}
+	case float64:
+		if typedActual, ok := actual.(float64); ok {
+			return typedExpected == typedActual
+		}
+	case bool:
+		if typedActual, ok := actual.(bool); ok {
+			return typedExpected == typedActual
+		}
+	case []byte:
+		if typedActual, ok := actual.([]byte); ok {
+			// a nil slice is not deeply equal to an empty slice.
+			if (typedExpected == nil) != (typedActual == nil) {
+				return false
+			}
+			return bytes.Equal(typedExpected, typedActual)
+		}
+	case time.Time:
+		// times are the same instant regardless of their location or monotonic clock reading.
+		if typedActual, ok := actual.(time.Time); ok {
+			return typedExpected.Equal(typedActual)
+		}
+	}
+	return areEqualReflect(expected, actual)
+}
+
+func areEqualReflect(expected, actual interface{}) bool {
 	if expected == nil && actual == nil {
 		return true
 	}
@@ -1226,56 +3935,154 @@ func areEqual(expected, actual interface{}) bool {
 	return reflect.DeepEqual(expected, actual)
 }
 
-func callerInfo() []string {
-	pc := uintptr(0)
-	file := ""
-	line := 0
-	ok := false
-	name := ""
+// areEqualValues returns if two objects are equal after converting either one to the other's type.
+// Numbers are compared by value, without truncating either side.
+func areEqualValues(expected, actual interface{}) bool {
+	if expected == nil || actual == nil {
+		return expected == nil && actual == nil
+	}
+	if reflect.DeepEqual(expected, actual) {
+		return true
+	}
 
-	callers := []string{}
-	for i := 0; ; i++ {
-		pc, file, line, ok = runtime.Caller(i)
-		if !ok {
-			return nil
-		}
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if isNumberKind(expectedValue.Kind()) && isNumberKind(actualValue.Kind()) {
+		return areNumbersEqual(expectedValue, actualValue)
+	}
+	// numbers are convertible to strings (as runes); that is not a value comparison.
+	if isNumberKind(expectedValue.Kind()) || isNumberKind(actualValue.Kind()) {
+		return false
+	}
+	if expectedValue.Type().ConvertibleTo(actualValue.Type()) &&
+		reflect.DeepEqual(expectedValue.Convert(actualValue.Type()).Interface(), actual) {
+		return true
+	}
+	if actualValue.Type().ConvertibleTo(expectedValue.Type()) &&
+		reflect.DeepEqual(expected, actualValue.Convert(expectedValue.Type()).Interface()) {
+		return true
+	}
+	return false
+}
 
-		if file == "<autogenerated>" {
-			break
-		}
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
 
-		parts := strings.Split(file, "/")
-		dir := parts[len(parts)-2]
-		file = parts[len(parts)-1]
-		if dir != "assert" && dir != "go-assert" && dir != "mock" && dir != "require" {
-			callers = append(callers, fmt.Sprintf("%s:%d", file, line))
-		}
+// areNumbersEqual compares two numeric values by value, regardless of their types.
+func areNumbersEqual(expected, actual reflect.Value) bool {
+	switch {
+	case isFloatKind(expected.Kind()) || isFloatKind(actual.Kind()):
+		return numberAsFloat(expected) == numberAsFloat(actual)
+	case isUintKind(expected.Kind()) && isUintKind(actual.Kind()):
+		return expected.Uint() == actual.Uint()
+	case isUintKind(expected.Kind()):
+		return actual.Int() >= 0 && expected.Uint() == uint64(actual.Int())
+	case isUintKind(actual.Kind()):
+		return expected.Int() >= 0 && uint64(expected.Int()) == actual.Uint()
+	default:
+		return expected.Int() == actual.Int()
+	}
+}
 
-		f := runtime.FuncForPC(pc)
-		if f == nil {
-			break
-		}
-		name = f.Name()
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
 
-		// Drop the package
-		segments := strings.Split(name, ".")
-		name = segments[len(segments)-1]
-		if isTest(name, "Test") ||
-			isTest(name, "Benchmark") ||
-			isTest(name, "Example") {
-			break
-		}
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
 	}
+}
 
-	return callers
+func numberAsFloat(value reflect.Value) float64 {
+	switch {
+	case isFloatKind(value.Kind()):
+		return value.Float()
+	case isUintKind(value.Kind()):
+		return float64(value.Uint())
+	default:
+		return float64(value.Int())
+	}
 }
 
 func color(input string, colorCode string) string {
 	return fmt.Sprintf("\033[%s;01m%s\033[0m", colorCode, input)
 }
 
+func noColor(input string, _ string) string {
+	return input
+}
+
+// stripColor removes ansi color codes from a string.
+func stripColor(input string) string {
+	if !strings.Contains(input, "\033[") {
+		return input
+	}
+	output := new(strings.Builder)
+	for index := 0; index < len(input); index++ {
+		if strings.HasPrefix(input[index:], "\033[") {
+			if end := strings.IndexByte(input[index:], 'm'); end > 0 {
+				index += end
+				continue
+			}
+		}
+		output.WriteByte(input[index])
+	}
+	return output.String()
+}
+
+// useColor returns if failure output should use color given an explicit setting and the output writer.
+func useColor(setting *bool, outputs []io.Writer) bool {
+	if setting != nil {
+		return *setting
+	}
+	for _, output := range outputs {
+		if typed, ok := output.(*os.File); ok {
+			if info, err := typed.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func reflectTypeName(object interface{}) string {
-	return reflect.TypeOf(object).Name()
+	if object == nil {
+		return "<nil>"
+	}
+	return typeName(reflect.TypeOf(object))
+}
+
+// typeName returns the name of a type, with named types qualified by their full package path,
+// e.g. `*github.com/blend/go-sdk/web.Config`.
+func typeName(t reflect.Type) string {
+	if t.Name() != "" && t.PkgPath() != "" {
+		return t.PkgPath() + "." + t.Name()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + typeName(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), typeName(t.Elem()))
+	case reflect.Map:
+		return "map[" + typeName(t.Key()) + "]" + typeName(t.Elem())
+	case reflect.Chan:
+		return t.ChanDir().String() + " " + typeName(t.Elem())
+	default:
+		return t.String()
+	}
 }
 
 func isTest(name, prefix string) bool {
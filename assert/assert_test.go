@@ -2,7 +2,16 @@ package assert
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"runtime"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -67,40 +76,88 @@ func TestIsZero(t *testing.T) {
 
 func TestGetLength(t *testing.T) {
 	emptyString := ""
-	l := getLength(emptyString)
+	l, _ := getLength(emptyString)
 	if l != 0 {
 		t.Errorf("getLength incorrect.")
 	}
 
 	notEmptyString := "foo"
-	l = getLength(notEmptyString)
+	l, _ = getLength(notEmptyString)
 	if l != 3 {
 		t.Errorf("getLength incorrect.")
 	}
 
 	emptyArray := []int{}
-	l = getLength(emptyArray)
+	l, _ = getLength(emptyArray)
 	if l != 0 {
 		t.Errorf("getLength incorrect.")
 	}
 
 	notEmptyArray := []int{1, 2, 3}
-	l = getLength(notEmptyArray)
+	l, _ = getLength(notEmptyArray)
 	if l != 3 {
 		t.Errorf("getLength incorrect.")
 	}
 
 	emptyMap := map[string]int{}
-	l = getLength(emptyMap)
+	l, _ = getLength(emptyMap)
 	if l != 0 {
 		t.Errorf("getLength incorrect.")
 	}
 
 	notEmptyMap := map[string]int{"foo": 1, "bar": 2, "baz": 3}
-	l = getLength(notEmptyMap)
+	l, _ = getLength(notEmptyMap)
 	if l != 3 {
 		t.Errorf("getLength incorrect.")
 	}
+
+	fixedArray := [3]int{1, 2, 3}
+	if l, _ = getLength(fixedArray); l != 3 {
+		t.Errorf("getLength incorrect for arrays.")
+	}
+	if l, _ = getLength(&fixedArray); l != 3 {
+		t.Errorf("getLength incorrect for pointers to arrays.")
+	}
+	if l, _ = getLength([0]int{}); l != 0 {
+		t.Errorf("getLength incorrect for empty arrays.")
+	}
+
+	var nilSlice []int
+	var nilMap map[string]int
+	var nilSlicePointer *[]int
+	for _, collection := range []interface{}{nilSlice, nilMap, nilSlicePointer, &nilSlice} {
+		if l, _ = getLength(collection); l != 0 {
+			t.Errorf("getLength incorrect for nil collection %#v.", collection)
+		}
+	}
+}
+
+func TestAssertLenEmptyArraysAndNilCollections(t *testing.T) {
+	var nilSlice []string
+	var nilMap map[string]int
+	err := safeExec(func() {
+		a := New(nil)
+		a.Len([3]int{1, 2, 3}, 3)
+		a.NotEmpty([1]string{"foo"})
+		a.Empty([0]string{})
+		a.Empty(nilSlice)
+		a.Empty(nilMap)
+		a.Empty(&nilSlice)
+		a.Len(&[]int{1, 2}, 2)
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic: %v", err)
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NotEmpty(nilMap)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
 }
 
 type myNestedStruct struct {
@@ -420,7 +477,7 @@ func TestAssertNew(t *testing.T) {
 func TestAssertWithOutput(t *testing.T) {
 	buf := bytes.NewBuffer(nil)
 	a := New(t).WithOutput(buf)
-	if a.output == nil {
+	if len(a.outputs) != 1 {
 		t.Errorf("should set output")
 		t.FailNow()
 	}
@@ -430,6 +487,70 @@ func TestAssertWithOutput(t *testing.T) {
 	}
 }
 
+type failingWriter struct{}
+
+func (failingWriter) Write(_ []byte) (int, error) {
+	return 0, fmt.Errorf("this is only a test")
+}
+
+func TestAssertWithOutputs(t *testing.T) {
+	first, second := bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+	a := New(nil).WithOutputs(first, failingWriter{}).WithOutput(second)
+	if len(a.Outputs()) != 3 {
+		t.Errorf("should have added each output, actual: %d", len(a.Outputs()))
+		t.FailNow()
+	}
+	if _, ok := a.Output().(*bytes.Buffer); ok {
+		t.Errorf("Output() should return a writer over every output")
+		t.FailNow()
+	}
+
+	err := safeExec(func() {
+		a.True(false)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if first.Len() == 0 || first.String() != second.String() {
+		t.Errorf("should have written the failure to every output, despite a failing output")
+		t.FailNow()
+	}
+
+	nonFatal := a.NonFatal()
+	if len(nonFatal.Outputs()) != 3 {
+		t.Errorf("the non fatal assertions should inherit the outputs")
+		t.FailNow()
+	}
+
+	third := bytes.NewBuffer(nil)
+	child := a.withTest(nil).WithOutput(third)
+	if len(child.Outputs()) != 4 || len(a.Outputs()) != 3 {
+		t.Errorf("adding an output to a copy should not change the original")
+		t.FailNow()
+	}
+}
+
+func TestAssertWithOutputsConcurrent(t *testing.T) {
+	output := bytes.NewBuffer(nil)
+	a := New(nil).WithOutputs(output, bytes.NewBuffer(nil))
+
+	var wg sync.WaitGroup
+	for x := 0; x < 8; x++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.NonFatal().True(false)
+		}()
+	}
+	wg.Wait()
+
+	if count := strings.Count(output.String(), "Assertion Failed!"); count != 8 {
+		t.Errorf("should have written every failure, actual: %d", count)
+		t.FailNow()
+	}
+}
+
 func TestAssertNotFatal(t *testing.T) {
 	buf := bytes.NewBuffer(nil)
 	a := New(t).WithOutput(buf)
@@ -438,7 +559,7 @@ func TestAssertNotFatal(t *testing.T) {
 		t.Errorf("should set t")
 		t.FailNow()
 	}
-	if nf.output == nil {
+	if len(nf.outputs) != 1 {
 		t.Errorf("should set output")
 		t.FailNow()
 	}
@@ -471,9 +592,31 @@ func TestAssertNil(t *testing.T) {
 	}
 }
 
-func TestAssertNotNil(t *testing.T) {
+type typeTestConfig struct{}
+
+func TestTypeName(t *testing.T) {
+	testCases := []struct {
+		Object   interface{}
+		Expected string
+	}{
+		{nil, "<nil>"},
+		{1, "int"},
+		{typeTestConfig{}, "github.com/blend/go-sdk/assert.typeTestConfig"},
+		{&typeTestConfig{}, "*github.com/blend/go-sdk/assert.typeTestConfig"},
+		{[]*typeTestConfig{}, "[]*github.com/blend/go-sdk/assert.typeTestConfig"},
+		{map[string]typeTestConfig{}, "map[string]github.com/blend/go-sdk/assert.typeTestConfig"},
+		{bytes.NewBuffer(nil), "*bytes.Buffer"},
+	}
+	for _, tc := range testCases {
+		if actual := reflectTypeName(tc.Object); actual != tc.Expected {
+			t.Errorf("expected: %s, actual: %s", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAssertIsType(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).NotNil("foo") // should be ok
+		New(nil).IsType(typeTestConfig{}, typeTestConfig{}) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -482,44 +625,67 @@ func TestAssertNotNil(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NotNil(nil)
+		New(nil).WithOutput(output).IsType(typeTestConfig{}, &typeTestConfig{})
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "Should be of type github.com/blend/go-sdk/assert.typeTestConfig, actual type: *github.com/blend/go-sdk/assert.typeTestConfig") {
+		t.Errorf("should have written both qualified type names, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).IsType(typeTestConfig{}, nil)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "actual is nil, expected type github.com/blend/go-sdk/assert.typeTestConfig") {
+		t.Errorf("should have written that actual is nil, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertLen(t *testing.T) {
+func TestAssertImplements(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).Len("foo", 3) // should be ok
+		New(nil).Implements((*io.Reader)(nil), bytes.NewBuffer(nil)) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
 
-	output := bytes.NewBuffer(nil)
-	err = safeExec(func() {
-		New(nil).WithOutput(output).Len([]string{}, 3)
-	})
-	if err == nil {
-		t.Errorf("should have produced a panic")
-		t.FailNow()
+	testCases := []struct {
+		Interface, Actual interface{}
+		Message           string
+	}{
+		{(*io.Reader)(nil), typeTestConfig{}, "Should implement io.Reader, actual type: github.com/blend/go-sdk/assert.typeTestConfig"},
+		{(*io.Reader)(nil), nil, "actual is nil, expected type io.Reader"},
+		{typeTestConfig{}, bytes.NewBuffer(nil), "should be given as a pointer to an interface"},
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
-		t.FailNow()
+	for _, tc := range testCases {
+		output := bytes.NewBuffer(nil)
+		err = safeExec(func() {
+			New(nil).WithOutput(output).Implements(tc.Interface, tc.Actual)
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic")
+			t.FailNow()
+		}
+		if !strings.Contains(output.String(), tc.Message) {
+			t.Errorf("should have written %q, actual: %s", tc.Message, output.String())
+			t.FailNow()
+		}
 	}
 }
 
-func TestAssertEmpty(t *testing.T) {
+func TestAssertNotNil(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).Empty("") // should be ok
+		New(nil).NotNil("foo") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -528,7 +694,7 @@ func TestAssertEmpty(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).Empty("foo")
+		New(nil).WithOutput(output).NotNil(nil)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -540,9 +706,9 @@ func TestAssertEmpty(t *testing.T) {
 	}
 }
 
-func TestAssertNotEmpty(t *testing.T) {
+func TestAssertLen(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).NotEmpty("foo") // should be ok
+		New(nil).Len("foo", 3) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -551,7 +717,7 @@ func TestAssertNotEmpty(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NotEmpty("")
+		New(nil).WithOutput(output).Len([]string{}, 3)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -563,9 +729,97 @@ func TestAssertNotEmpty(t *testing.T) {
 	}
 }
 
-func TestAssertEqual(t *testing.T) {
+func TestAssertLenAtLeast(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+
+	for _, testCase := range []struct {
+		Collection interface{}
+		Min        int
+		OK         bool
+	}{
+		{Collection: []int{1, 2, 3}, Min: 2, OK: true},
+		{Collection: []int{1, 2, 3}, Min: 3, OK: true},
+		{Collection: []int{1, 2, 3}, Min: 4},
+		{Collection: map[string]int{"foo": 1}, Min: 1, OK: true},
+		{Collection: map[string]int{}, Min: 1},
+		{Collection: "foo", Min: 2, OK: true},
+		{Collection: "", Min: 1},
+		{Collection: ch, Min: 2, OK: true},
+		{Collection: ch, Min: 3},
+		{Collection: [2]string{"foo", "bar"}, Min: 2, OK: true},
+		{Collection: &[]int{1, 2}, Min: 2, OK: true},
+		{Collection: 1234, Min: 0},
+		{Collection: struct{}{}, Min: 0},
+	} {
+		output := bytes.NewBuffer(nil)
+		err := safeExec(func() {
+			New(nil).WithOutput(output).LenAtLeast(testCase.Collection, testCase.Min)
+		})
+		if testCase.OK && err != nil {
+			t.Errorf("should not have produced a panic for %#v and %d", testCase.Collection, testCase.Min)
+			t.FailNow()
+		}
+		if !testCase.OK && (err == nil || len(output.String()) == 0) {
+			t.Errorf("should have produced a panic and output for %#v and %d", testCase.Collection, testCase.Min)
+			t.FailNow()
+		}
+	}
+}
+
+func TestAssertLenAtMost(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+
+	for _, testCase := range []struct {
+		Collection interface{}
+		Max        int
+		OK         bool
+	}{
+		{Collection: []int{1, 2, 3}, Max: 4, OK: true},
+		{Collection: []int{1, 2, 3}, Max: 3, OK: true},
+		{Collection: []int{1, 2, 3}, Max: 2},
+		{Collection: map[string]int{"foo": 1, "bar": 2}, Max: 2, OK: true},
+		{Collection: map[string]int{"foo": 1, "bar": 2}, Max: 1},
+		{Collection: "foo", Max: 3, OK: true},
+		{Collection: "foo", Max: 2},
+		{Collection: ch, Max: 2, OK: true},
+		{Collection: ch, Max: 1},
+		{Collection: [2]string{"foo", "bar"}, Max: 1},
+		{Collection: (*[]int)(nil), Max: 0, OK: true},
+		{Collection: 1234, Max: 10},
+		{Collection: true, Max: 10},
+	} {
+		output := bytes.NewBuffer(nil)
+		err := safeExec(func() {
+			New(nil).WithOutput(output).LenAtMost(testCase.Collection, testCase.Max)
+		})
+		if testCase.OK && err != nil {
+			t.Errorf("should not have produced a panic for %#v and %d", testCase.Collection, testCase.Max)
+			t.FailNow()
+		}
+		if !testCase.OK && (err == nil || len(output.String()) == 0) {
+			t.Errorf("should have produced a panic and output for %#v and %d", testCase.Collection, testCase.Max)
+			t.FailNow()
+		}
+	}
+
+	output := bytes.NewBuffer(nil)
+	New(nil).WithOutput(output).NonFatal().LenAtMost(1234, 10)
+	if !strings.Contains(output.String(), "Should be a collection with a length, actual type: int") {
+		t.Errorf("should have failed for a type without a length, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertChannelLen(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
 	err := safeExec(func() {
-		New(nil).Equal("foo", "foo") // should be ok
+		New(nil).ChannelLen(ch, 2) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -574,82 +828,101 @@ func TestAssertEqual(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).Equal("foo", "bar")
+		New(nil).WithOutput(output).ChannelLen(ch, 3)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "Actual") {
+		t.Errorf("Should have written the actual length on failure")
 		t.FailNow()
 	}
-}
 
-func TestAssertReferenceEqual(t *testing.T) {
-	obj1 := "foo"
-	obj2 := "foo"
-	ref1 := &obj1
-	ref2 := &obj1
-	ref3 := &obj2
+	var nilChannel chan int
+	for _, invalid := range []interface{}{nil, nilChannel, []int{1, 2}} {
+		output = bytes.NewBuffer(nil)
+		err = safeExec(func() {
+			New(nil).WithOutput(output).ChannelLen(invalid, 2)
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic for %#v", invalid)
+			t.FailNow()
+		}
+		if len(output.String()) == 0 {
+			t.Errorf("Should have written output on failure")
+			t.FailNow()
+		}
+	}
+}
 
+func TestAssertReceivesWithin(t *testing.T) {
+	ch := make(chan string, 1)
+	ch <- "foo"
+	var value interface{}
 	err := safeExec(func() {
-		New(nil).ReferenceEqual(ref1, ref2) // should be ok
+		value = New(nil).ReceivesWithin(ch, time.Second) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
+	if value != "foo" {
+		t.Errorf("should have returned the received value, actual: %v", value)
+		t.FailNow()
+	}
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).ReferenceEqual(ref1, ref3)
+		New(nil).WithOutput(output).ReceivesWithin(ch, time.Millisecond)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "string") || !strings.Contains(output.String(), "1ms") {
+		t.Errorf("should have written the element type and timeout on failure, actual: %s", output.String())
 		t.FailNow()
 	}
-}
 
-func TestAssertNotEqual(t *testing.T) {
-	err := safeExec(func() {
-		New(nil).NotEqual("foo", "bar") // should be ok
+	close(ch)
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).ReceivesWithin(ch, time.Second)
 	})
-	if err != nil {
-		t.Errorf("should not have produced a panic")
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Channel closed") {
+		t.Errorf("should have written that the channel closed, actual: %s", output.String())
 		t.FailNow()
 	}
 
-	output := bytes.NewBuffer(nil)
+	output = bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NotEqual("foo", "foo")
+		New(nil).WithOutput(output).ReceivesWithin("not a channel", time.Second)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
-		t.FailNow()
-	}
 }
 
-func TestAssertZero(t *testing.T) {
+func TestAssertDoesNotReceiveWithin(t *testing.T) {
+	ch := make(chan int, 1)
 	err := safeExec(func() {
-		New(nil).Zero(0) // should be ok
+		New(nil).DoesNotReceiveWithin(ch, time.Millisecond) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
 
+	ch <- 1
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).Zero(1)
+		New(nil).WithOutput(output).DoesNotReceiveWithin(ch, time.Second)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -659,11 +932,27 @@ func TestAssertZero(t *testing.T) {
 		t.Errorf("Should have written output on failure")
 		t.FailNow()
 	}
+
+	close(ch)
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).DoesNotReceiveWithin(ch, time.Second)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Channel closed") {
+		t.Errorf("should have written that the channel closed, actual: %s", output.String())
+		t.FailNow()
+	}
 }
 
-func TestAssertNotZero(t *testing.T) {
+func TestAssertClosedWithin(t *testing.T) {
+	done := make(chan struct{})
+	go func() { close(done) }()
 	err := safeExec(func() {
-		New(nil).NotZero(1) // should be ok
+		New(nil).ClosedWithin(done, time.Second) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -672,44 +961,67 @@ func TestAssertNotZero(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NotZero(0)
+		New(nil).WithOutput(output).ClosedWithin(make(chan struct{}), time.Millisecond)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "Timed out waiting") {
+		t.Errorf("should have written that the close timed out, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	ch := make(chan int, 1)
+	ch <- 1
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).ClosedWithin(ch, time.Second)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "received a value but was not closed") {
+		t.Errorf("should have written that a value was received, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertTrue(t *testing.T) {
+func TestAssertNotClosed(t *testing.T) {
+	ch := make(chan struct{})
 	err := safeExec(func() {
-		New(nil).True(1 == 1) // should be ok
+		New(nil).NotClosed(ch) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
 
+	close(ch)
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).True(1 == 0)
+		New(nil).WithOutput(output).NotClosed(ch)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "Channel should not be closed") {
+		t.Errorf("should have written that the channel closed, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertFalse(t *testing.T) {
+func TestAssertMapContains(t *testing.T) {
+	response := map[string]interface{}{
+		"id":     "1234",
+		"status": "ok",
+		"count":  3,
+		"tags":   []string{"foo", "bar"},
+	}
 	err := safeExec(func() {
-		New(nil).False(1 == 0) // should be ok
+		New(nil).MapContains(response, map[string]interface{}{"status": "ok", "tags": []string{"foo", "bar"}}) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -718,49 +1030,38 @@ func TestAssertFalse(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).False(1 == 1)
+		New(nil).WithOutput(output).MapContains(response, map[string]interface{}{"status": "failed", "count": 3, "name": "foo", "error": nil})
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), `Map should contain keys: "error", "name"`) {
+		t.Errorf("should have written the missing keys, actual: %s", output.String())
 		t.FailNow()
 	}
-}
-
-func TestAssertInDelta(t *testing.T) {
-	err := safeExec(func() {
-		New(nil).InDelta(1, 2, 1)   // should be ok
-		New(nil).InDelta(1, 1.5, 1) // should be ok
-	})
-	if err != nil {
-		t.Errorf("should not have produced a panic")
+	if !strings.Contains(output.String(), `"status": expected: "failed", actual: "ok"`) {
+		t.Errorf("should have written the mismatched values, actual: %s", output.String())
 		t.FailNow()
 	}
 
-	output := bytes.NewBuffer(nil)
+	output = bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).InDelta(1, 3, 1)
+		New(nil).WithOutput(output).MapContains([]string{"foo"}, map[string]string{})
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "Should be a map") {
+		t.Errorf("should have written that the superset is not a map, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertInTimeDelta(t *testing.T) {
-	t1 := time.Date(2018, 04, 10, 12, 00, 00, 00, time.UTC)
-	t2 := time.Date(2018, 04, 10, 12, 00, 01, 00, time.UTC)
-	t3 := time.Date(2018, 04, 10, 12, 01, 00, 00, time.UTC)
-
+func TestAssertEmpty(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).InTimeDelta(t1, t2, time.Second) // should be ok
+		New(nil).Empty("") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -769,7 +1070,7 @@ func TestAssertInTimeDelta(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).InTimeDelta(t1, t3, time.Second)
+		New(nil).WithOutput(output).Empty("foo")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -781,9 +1082,9 @@ func TestAssertInTimeDelta(t *testing.T) {
 	}
 }
 
-func TestAssertContains(t *testing.T) {
+func TestAssertNotEmpty(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).Contains("foo bar", "foo") // should be ok
+		New(nil).NotEmpty("foo") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -792,7 +1093,7 @@ func TestAssertContains(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).Contains("foo bar", "baz")
+		New(nil).WithOutput(output).NotEmpty("")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -804,9 +1105,10 @@ func TestAssertContains(t *testing.T) {
 	}
 }
 
-func TestAssertNotContains(t *testing.T) {
+func TestAssertValidUTF8(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).NotContains("foo bar", "buzz") // should be ok
+		New(nil).ValidUTF8([]byte("hello, 世界")) // should be ok
+		New(nil).ValidUTF8(nil)                 // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -815,21 +1117,21 @@ func TestAssertNotContains(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NotContains("foo bar", "foo")
+		New(nil).WithOutput(output).ValidUTF8([]byte{'a', 'b', 0xe4, 0xb8, 'c'})
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "invalid sequence at byte offset 2") {
+		t.Errorf("should have written the offset of the invalid sequence, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertAny(t *testing.T) {
+func TestAssertASCIIOnly(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 1 }) // should be ok
+		New(nil).ASCIIOnly([]byte("hello, world\n")) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -838,21 +1140,24 @@ func TestAssertAny(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 0 }) // should not be ok
+		New(nil).WithOutput(output).ASCIIOnly([]byte("café"))
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "non-ASCII byte 0xc3 at byte offset 3") {
+		t.Errorf("should have written the non-ASCII byte and its offset, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertAnyOfInt(t *testing.T) {
+func TestAssertContainsFold(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).AnyOfInt([]int{1, 2, 3}, func(v int) bool { return v == 1 }) // should be ok
+		New(nil).ContainsFold("Content-Type: application/JSON", "content-type: APPLICATION/json") // should be ok
+		New(nil).ContainsFold("ΣΊΣΥΦΟΣ", "σίσυφος")                                               // should be ok
+		New(nil).ContainsFold("100K", "100k")                                                     // should be ok, the kelvin sign folds to k
+		New(nil).ContainsFold("anything", "")                                                     // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -861,21 +1166,23 @@ func TestAssertAnyOfInt(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).AnyOfInt([]int{1, 2, 3}, func(v int) bool { return v == 0 }) // should not  be ok
+		// the dotted capital i only lowers to an ascii i, it does not fold to it.
+		New(nil).WithOutput(output).ContainsFold("İstanbul", "istanbul")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "should contain `istanbul` ignoring case") {
+		t.Errorf("should have written the substring, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertAnyOfFloat64(t *testing.T) {
+func TestAssertNotContainsFold(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).AnyOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v == 1 }) // should be ok
+		New(nil).NotContainsFold("ışık", "IŞIK")         // should be ok, the dotless i does not fold to I
+		New(nil).NotContainsFold("İstanbul", "istanbul") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -884,21 +1191,21 @@ func TestAssertAnyOfFloat64(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).AnyOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v == 0 }) // should not be ok
+		New(nil).WithOutput(output).NotContainsFold("HELLO World", "world")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "should not contain `world` ignoring case") {
+		t.Errorf("should have written the substring, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertAnyOfString(t *testing.T) {
+func TestAssertHasPrefix(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).AnyOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "foo" }) // should be ok
+		New(nil).HasPrefix("ışık", "ı") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -907,21 +1214,21 @@ func TestAssertAnyOfString(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).AnyOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "buzz" }) // should not be ok
+		New(nil).WithOutput(output).HasPrefix("ışık", "I")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "`ışık` should start with `I`") {
+		t.Errorf("should have written the prefix, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertAll(t *testing.T) {
+func TestAssertHasSuffix(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 0 }) // should be ok
+		New(nil).HasSuffix("DİYARBAKIR", "KIR") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -930,21 +1237,21 @@ func TestAssertAll(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 1 }) // should not be ok
+		New(nil).WithOutput(output).HasSuffix("DİYARBAKIR", "kır")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), "`DİYARBAKIR` should end with `kır`") {
+		t.Errorf("should have written the suffix, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertAllOfInt(t *testing.T) {
+func TestAssertBlank(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).AllOfInt([]int{1, 2, 3}, func(v int) bool { return v > 0 }) // should be ok
+		New(nil).Blank(" \t\n") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -953,21 +1260,21 @@ func TestAssertAllOfInt(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).AllOfInt([]int{1, 2, 3}, func(v int) bool { return v > 1 }) // should not  be ok
+		New(nil).WithOutput(output).Blank(" foo ")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("Should have written output on failure")
+	if !strings.Contains(output.String(), `" foo "`) {
+		t.Errorf("should have quoted the actual value in the output, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertAllOfFloat64(t *testing.T) {
+func TestAssertNotBlank(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).AllOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 0 }) // should be ok
+		New(nil).NotBlank(" foo ") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -976,21 +1283,94 @@ func TestAssertAllOfFloat64(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).AllOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 1 }) // should not be ok
+		New(nil).WithOutput(output).NotBlank("   ")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
+	if !strings.Contains(output.String(), `"   "`) {
+		t.Errorf("should have quoted the actual value in the output, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertEqual(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).Equal("foo", "foo") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).Equal("foo", "bar")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
 		t.Errorf("Should have written output on failure")
 		t.FailNow()
 	}
 }
 
-func TestAssertAllOfString(t *testing.T) {
+func TestAssertExactEqual(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).AllOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return len(v) == 3 }) // should be ok
+		New(nil).ExactEqual(int64(1), int64(1))                           // should be ok
+		New(nil).ExactEqual([]string{"foo"}, []string{"foo"})             // should be ok
+		New(nil).ExactEqual(nil, nil)                                     // should be ok
+		New(nil).ExactEqual(myNestedStruct{ID: 1}, myNestedStruct{ID: 1}) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic: %v", err)
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).ExactEqual(int64(1), int32(1))
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic for different types")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "types differ: int64 vs int32") {
+		t.Errorf("should have written the type mismatch, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).ExactEqual(int64(1), int64(2))
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic for different values")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Objects should be exactly equal") || strings.Contains(output.String(), "types differ") {
+		t.Errorf("should have written the value mismatch, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().ExactEqual(nil, (*int)(nil)) {
+		t.Errorf("should have failed for a typed nil")
+		t.FailNow()
+	}
+}
+
+func TestAssertReferenceEqual(t *testing.T) {
+	obj1 := "foo"
+	obj2 := "foo"
+	ref1 := &obj1
+	ref2 := &obj1
+	ref3 := &obj2
+
+	err := safeExec(func() {
+		New(nil).ReferenceEqual(ref1, ref2) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -999,7 +1379,7 @@ func TestAssertAllOfString(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).AllOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "foo" }) // should not be ok
+		New(nil).WithOutput(output).ReferenceEqual(ref1, ref3)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -1011,9 +1391,135 @@ func TestAssertAllOfString(t *testing.T) {
 	}
 }
 
-func TestAssertNone(t *testing.T) {
+func TestAreEqualFastPaths(t *testing.T) {
+	nan := math.NaN()
+	testCases := []struct {
+		Expected, Actual interface{}
+	}{
+		{1, 1},
+		{1, 2},
+		{1, int64(1)},
+		{int64(1), int64(1)},
+		{int64(1), int64(2)},
+		{int64(1), 1},
+		{"foo", "foo"},
+		{"foo", "bar"},
+		{"", ""},
+		{1.5, 1.5},
+		{1.5, 2.5},
+		{nan, nan},
+		{math.Copysign(0, -1), 0.0},
+		{true, true},
+		{true, false},
+		{[]byte("foo"), []byte("foo")},
+		{[]byte("foo"), []byte("bar")},
+		{[]byte{}, []byte{}},
+		{[]byte(nil), []byte(nil)},
+		{[]byte(nil), []byte{}},
+		{[]byte{}, []byte(nil)},
+		{[]byte("foo"), "foo"},
+		{nil, nil},
+		{nil, 0},
+		{0, nil},
+	}
+
+	for _, tc := range testCases {
+		if fast, reflected := areEqual(tc.Expected, tc.Actual), areEqualReflect(tc.Expected, tc.Actual); fast != reflected {
+			t.Errorf("areEqual(%#v, %#v) should match the reflection result %v", tc.Expected, tc.Actual, reflected)
+		}
+	}
+}
+
+func TestGetLengthFastPaths(t *testing.T) {
+	testCases := []interface{}{
+		nil,
+		"",
+		"foo",
+		[]byte(nil),
+		[]byte("foo"),
+		[]int{},
+		[]int{1, 2, 3},
+		[]string{"foo", "bar"},
+		[]interface{}{1, "foo"},
+		map[string]string{"foo": "bar"},
+		map[string]interface{}{"foo": 1, "bar": 2},
+	}
+
+	for _, tc := range testCases {
+		fast, fastOK := getLength(tc)
+		reflected, reflectedOK := getLengthReflect(tc)
+		if fast != reflected || fastOK != reflectedOK {
+			t.Errorf("getLength(%#v) should match the reflection result %d, actual: %d", tc, reflected, fast)
+		}
+	}
+}
+
+func BenchmarkAreEqual(b *testing.B) {
+	for x := 0; x < b.N; x++ {
+		areEqual(x, x)
+		areEqual("foo", "foo")
+	}
+}
+
+func BenchmarkAreEqualReflect(b *testing.B) {
+	for x := 0; x < b.N; x++ {
+		areEqualReflect(x, x)
+		areEqualReflect("foo", "foo")
+	}
+}
+
+func BenchmarkGetLength(b *testing.B) {
+	values := []int{1, 2, 3}
+	for x := 0; x < b.N; x++ {
+		getLength(values)
+		getLength("foo")
+	}
+}
+
+func BenchmarkGetLengthReflect(b *testing.B) {
+	values := []int{1, 2, 3}
+	for x := 0; x < b.N; x++ {
+		getLengthReflect(values)
+		getLengthReflect("foo")
+	}
+}
+
+func TestAreEqualValues(t *testing.T) {
+	type myString string
+	testCases := []struct {
+		Expected, Actual interface{}
+		IsEqual          bool
+	}{
+		{nil, nil, true},
+		{nil, 0, false},
+		{int32(5), int64(5), true},
+		{int64(5), int32(5), true},
+		{uint8(5), int(5), true},
+		{int(5), uint64(5), true},
+		{int(-1), uint64(18446744073709551615), false},
+		{int64(300), int8(44), false},
+		{int8(44), int64(300), false},
+		{float32(1.5), float64(1.5), true},
+		{int(2), float64(2), true},
+		{int(2), float64(2.5), false},
+		{"foo", myString("foo"), true},
+		{myString("foo"), "foo", true},
+		{65, "A", false},
+		{"A", 65, false},
+		{[]byte("foo"), "foo", true},
+		{"foo", []byte("foo"), true},
+	}
+
+	for _, tc := range testCases {
+		if actual := areEqualValues(tc.Expected, tc.Actual); actual != tc.IsEqual {
+			t.Errorf("areEqualValues(%#v, %#v) should be %v", tc.Expected, tc.Actual, tc.IsEqual)
+		}
+	}
+}
+
+func TestAssertEqualValues(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 3 }) // should be ok
+		New(nil).EqualValues(int32(5), int64(5)) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -1022,7 +1528,7 @@ func TestAssertNone(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 2 }) // should not be ok
+		New(nil).WithOutput(output).EqualValues(int64(300), int8(44))
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -1034,9 +1540,9 @@ func TestAssertNone(t *testing.T) {
 	}
 }
 
-func TestAssertNoneOfInt(t *testing.T) {
+func TestAssertNotEqual(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).NoneOfInt([]int{1, 2, 3}, func(v int) bool { return v > 3 }) // should be ok
+		New(nil).NotEqual("foo", "bar") // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -1045,7 +1551,7 @@ func TestAssertNoneOfInt(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NoneOfInt([]int{1, 2, 3}, func(v int) bool { return v > 2 }) // should not  be ok
+		New(nil).WithOutput(output).NotEqual("foo", "foo")
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -1057,9 +1563,9 @@ func TestAssertNoneOfInt(t *testing.T) {
 	}
 }
 
-func TestAssertNoneOfFloat64(t *testing.T) {
+func TestAssertZero(t *testing.T) {
 	err := safeExec(func() {
-		New(nil).NoneOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 3 }) // should be ok
+		New(nil).Zero(0) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -1068,7 +1574,7 @@ func TestAssertNoneOfFloat64(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NoneOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 2 }) // should not be ok
+		New(nil).WithOutput(output).Zero(1)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -1080,9 +1586,25 @@ func TestAssertNoneOfFloat64(t *testing.T) {
 	}
 }
 
-func TestAssertNoneOfString(t *testing.T) {
+type requiredFieldsTestConfig struct {
+	Name     string
+	Port     int
+	Tags     []string
+	Database struct {
+		Host string
+		User string
+	}
+	TLS *struct {
+		CertPath string
+	}
+}
+
+func TestAssertRequiredFieldsSet(t *testing.T) {
+	cfg := requiredFieldsTestConfig{Name: "test", Port: 8080}
+	cfg.Database.Host = "localhost"
+
 	err := safeExec(func() {
-		New(nil).NoneOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return len(v) == 0 }) // should be ok
+		New(nil).RequiredFieldsSet(&cfg, []string{"Name", "Port", "Database.Host"}) // should be ok
 	})
 	if err != nil {
 		t.Errorf("should not have produced a panic")
@@ -1091,7 +1613,43 @@ func TestAssertNoneOfString(t *testing.T) {
 
 	output := bytes.NewBuffer(nil)
 	err = safeExec(func() {
-		New(nil).WithOutput(output).NoneOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "foo" }) // should not be ok
+		New(nil).WithOutput(output).RequiredFieldsSet(cfg, []string{"Name", "Tags", "Database.Host", "Database.User", "TLS.CertPath"})
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Required fields should be set: Tags, Database.User, TLS.CertPath") {
+		t.Errorf("should have named every unset field, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).RequiredFieldsSet(cfg, []string{"Name", "Database.Password"})
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "should exist on assert.requiredFieldsTestConfig: Database.Password") {
+		t.Errorf("should have named the unknown field, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNotZero(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NotZero(1) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NotZero(0)
 	})
 	if err == nil {
 		t.Errorf("should have produced a panic")
@@ -1103,18 +1661,2319 @@ func TestAssertNoneOfString(t *testing.T) {
 	}
 }
 
-// -----
-// Optional / NotFatal
-// -----
+type fieldPathAddress struct {
+	Zip string `json:"zip"`
+}
 
-func TestAssertNonFatalNil(t *testing.T) {
-	if !New(nil).NonFatal().Nil(nil) { // should be ok {
-		t.Errorf("should not have failed")
+type fieldPathUser struct {
+	Name      string
+	Addresses []fieldPathAddress `json:"addresses"`
+	Manager   *fieldPathUser
+	Meta      map[string]interface{}
+	secret    string
+}
+
+func TestAssertFieldEqual(t *testing.T) {
+	object := map[string]interface{}{
+		"user": &fieldPathUser{
+			Name:      "foo",
+			Addresses: []fieldPathAddress{{Zip: "12345"}, {Zip: "67890"}},
+			Meta:      map[string]interface{}{"tags": []interface{}{"a", "b"}},
+			secret:    "bar",
+		},
+	}
+	decoded := map[string]interface{}{}
+	json.Unmarshal([]byte(`{"user":{"addresses":[{"zip":"12345"}],"age":30}}`), &decoded)
+
+	for _, testCase := range []struct {
+		Object   interface{}
+		Path     string
+		Expected interface{}
+		Message  string
+	}{
+		{Object: object, Path: "user.addresses[1].zip", Expected: "67890"},
+		{Object: object, Path: "user.Addresses[0].Zip", Expected: "12345"},
+		{Object: object, Path: "user.name", Expected: "foo"},
+		{Object: object, Path: "user.Meta.tags[1]", Expected: "b"},
+		{Object: decoded, Path: "user.addresses[0].zip", Expected: "12345"},
+		{Object: decoded, Path: "user.age", Expected: 30},
+		{Object: []int{1, 2}, Path: "[1]", Expected: 2},
+		{Object: object, Path: "user.name", Expected: "bar", Message: "Field `user.name` should be equal"},
+		{Object: object, Path: "user.addresses[2].zip", Message: "Field path `user.addresses[2].zip` resolved to `user.addresses`, then index 2 is out of range with length 2 on type: []github.com/blend/go-sdk/assert.fieldPathAddress"},
+		{Object: object, Path: "user.manager.name", Message: "resolved to `user.manager`, then found nil before `name` on type: *github.com/blend/go-sdk/assert.fieldPathUser"},
+		{Object: object, Path: "user.email", Message: "resolved to `user`, then no field `email` on type: github.com/blend/go-sdk/assert.fieldPathUser"},
+		{Object: object, Path: "user.secret", Message: "then field `secret` is unexported"},
+		{Object: object, Path: "account.name", Message: "resolved to `(root)`, then no key `account` on type: map[string]interface {}"},
+		{Object: object, Path: "user.name[0]", Message: "resolved to `user.name`, then cannot index `[0]` on type: string"},
+		{Object: decoded, Path: "user.age.value", Message: "resolved to `user.age`, then cannot get `value` on type: float64"},
+		{Object: object, Path: "user..name", Message: "Field path `user..name` is invalid"},
+		{Object: object, Path: "user.addresses[x]", Message: "is invalid"},
+		{Object: object, Path: "", Message: "is invalid"},
+	} {
+		didFail, message := shouldHaveFieldEqual(testCase.Object, testCase.Path, testCase.Expected)
+		if testCase.Message == "" {
+			if didFail {
+				t.Errorf("%s: should not have failed, actual: %s", testCase.Path, message)
+			}
+			continue
+		}
+		if !didFail {
+			t.Errorf("%s: should have failed", testCase.Path)
+			continue
+		}
+		if !strings.Contains(message, testCase.Message) {
+			t.Errorf("%s: message should contain %q, actual: %s", testCase.Path, testCase.Message, message)
+		}
+	}
+
+	err := safeExec(func() {
+		New(nil).FieldEqual(object, "user.addresses[0].zip", "12345") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).FieldEqual(object, "user.addresses[0].zip", "54321")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Field `user.addresses[0].zip` should be equal") {
+		t.Errorf("should have written the field path, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertFieldNotZero(t *testing.T) {
+	object := fieldPathUser{Name: "foo", Addresses: []fieldPathAddress{{}}}
+
+	err := safeExec(func() {
+		New(nil).FieldNotZero(object, "name")       // should be ok
+		New(nil).FieldNotZero(&object, "addresses") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().Nil("foo") {
+	err = safeExec(func() {
+		New(nil).WithOutput(output).FieldNotZero(object, "addresses[0].zip")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Field `addresses[0].zip` should be non-zero") {
+		t.Errorf("should have written the field path, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertTrue(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).True(1 == 1) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).True(1 == 0)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertFalse(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).False(1 == 0) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).False(1 == 1)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertInDelta(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).InDelta(1, 2, 1)   // should be ok
+		New(nil).InDelta(1, 1.5, 1) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).InDelta(1, 3, 1)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertInDeltaNaN(t *testing.T) {
+	for _, values := range [][3]float64{
+		{math.NaN(), 1, 1},
+		{1, math.NaN(), 1},
+		{math.NaN(), math.NaN(), 1},
+		{1, 1, math.NaN()},
+	} {
+		output := bytes.NewBuffer(nil)
+		err := safeExec(func() {
+			New(nil).WithOutput(output).InDelta(values[0], values[1], values[2])
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic for %v", values)
+			t.FailNow()
+		}
+		if !strings.Contains(output.String(), "NaN is never in delta") {
+			t.Errorf("should have explained NaN in the output, actual: %s", output.String())
+			t.FailNow()
+		}
+	}
+}
+
+func TestAssertIsNaN(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).IsNaN(math.NaN())   // should be ok
+		New(nil).NotNaN(1)           // should be ok
+		New(nil).NotNaN(math.Inf(1)) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).IsNaN(1)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Should be NaN, actual: 1") {
+		t.Errorf("should have written output on failure, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NotNaN(math.NaN())
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertIsInf(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).IsInf(math.Inf(1), 1)   // should be ok
+		New(nil).IsInf(math.Inf(-1), -1) // should be ok
+		New(nil).IsInf(math.Inf(-1), 0)  // should be ok
+		New(nil).NotInf(1, 0)            // should be ok
+		New(nil).NotInf(math.NaN(), 0)   // should be ok
+		New(nil).NotInf(math.Inf(-1), 1) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).IsInf(math.Inf(1), -1)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Should be negative infinity, actual: +Inf") {
+		t.Errorf("should have written output on failure, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NotInf(math.Inf(1), 0)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Should not be an infinity") {
+		t.Errorf("should have written output on failure, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNotInDelta(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NotInDelta(1, 2, 0.5) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	for _, testCase := range []struct {
+		F0, F1, Delta float64
+		Message       string
+	}{
+		{F0: 1, F1: 1.5, Delta: 0.5, Message: "Absolute difference of 1.00000 and 1.50000 should be greater than 0.50000, actual: 0.50000"},
+		{F0: 1, F1: 1, Delta: 0, Message: "actual: 0.00000"},
+		{F0: math.NaN(), F1: 1, Delta: 0.5, Message: "NaN is never outside of delta"},
+		{F0: 1, F1: math.NaN(), Delta: 0.5, Message: "NaN is never outside of delta"},
+	} {
+		output := bytes.NewBuffer(nil)
+		err = safeExec(func() {
+			New(nil).WithOutput(output).NotInDelta(testCase.F0, testCase.F1, testCase.Delta)
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic for %v and %v", testCase.F0, testCase.F1)
+			t.FailNow()
+		}
+		if !strings.Contains(output.String(), testCase.Message) {
+			t.Errorf("should have written %q to the output, actual: %s", testCase.Message, output.String())
+			t.FailNow()
+		}
+	}
+}
+
+func TestAssertMapInDelta(t *testing.T) {
+	expected := map[string]float64{"cpu": 0.5, "memory": 1024, "latency": 0.25}
+	err := safeExec(func() {
+		New(nil).MapInDelta(expected, map[string]float64{"cpu": 0.5001, "memory": 1023.9999, "latency": 0.2499}, 0.001) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).MapInDelta(expected, map[string]float64{"cpu": 0.6, "memory": 1024, "disk": 10}, 0.001)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	for _, message := range []string{
+		"Map should have keys: latency",
+		"Map should not have keys: disk",
+		"Map values should be within 0.001: cpu (expected: 0.5, actual: 0.6)",
+	} {
+		if !strings.Contains(output.String(), message) {
+			t.Errorf("should have written %q, actual: %s", message, output.String())
+			t.FailNow()
+		}
+	}
+}
+
+func TestAssertInEpsilon(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).InEpsilon(1e9, 1.0001e9, 0.001)            // should be ok
+		New(nil).InEpsilon(1e-9, 1.0001e-9, 0.001)          // should be ok
+		New(nil).InEpsilon(-100, -101, 0.01)                // should be ok
+		New(nil).InEpsilon(0, 0.0001, 0.001)                // should be ok
+		New(nil).InEpsilon(math.Inf(1), math.Inf(1), 0.001) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	for _, testCase := range []struct {
+		Expected, Actual, Epsilon float64
+		Message                   string
+	}{
+		{Expected: 1e-9, Actual: 2e-9, Epsilon: 0.001, Message: "Relative error of 1e-09 and 2e-09 should be at most 0.001, actual: 1"},
+		{Expected: 0, Actual: 0.1, Epsilon: 0.001, Message: "should be at most 0.001 when expected is 0, actual: 0.1"},
+		{Expected: math.NaN(), Actual: 1, Epsilon: 0.001, Message: "NaN is never in epsilon"},
+		{Expected: 1, Actual: math.NaN(), Epsilon: 0.001, Message: "NaN is never in epsilon"},
+		{Expected: math.Inf(1), Actual: math.Inf(-1), Epsilon: 0.001, Message: "only defined for equal infinities"},
+		{Expected: 1, Actual: math.Inf(1), Epsilon: 0.001, Message: "only defined for equal infinities"},
+	} {
+		output := bytes.NewBuffer(nil)
+		err = safeExec(func() {
+			New(nil).WithOutput(output).InEpsilon(testCase.Expected, testCase.Actual, testCase.Epsilon)
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic for %v and %v", testCase.Expected, testCase.Actual)
+			t.FailNow()
+		}
+		if !strings.Contains(output.String(), testCase.Message) {
+			t.Errorf("should have written %q to the output, actual: %s", testCase.Message, output.String())
+			t.FailNow()
+		}
+	}
+}
+
+func TestAssertWithinPercent(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).WithinPercent(98, 100, 5)   // should be ok
+		New(nil).WithinPercent(105, 100, 5)  // should be ok
+		New(nil).WithinPercent(-98, -100, 5) // should be ok
+		New(nil).WithinPercent(0, 0, 5)      // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).WithinPercent(90, 100, 5)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "90 should be within 5% of 100, actual difference: 10.00%") {
+		t.Errorf("should have written the actual difference, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).WithinPercent(0.001, 0, 5)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "0.001 should be within 5% of 0") {
+		t.Errorf("should have written output on failure, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertPositive(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).Positive(1)           // should be ok
+		New(nil).Positive(int8(1))     // should be ok
+		New(nil).Positive(uint64(1))   // should be ok
+		New(nil).Positive(0.001)       // should be ok
+		New(nil).Positive(float32(10)) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	for _, value := range []interface{}{-1, 0, uint(0), -0.5, math.NaN(), "1", nil} {
+		output := bytes.NewBuffer(nil)
+		err = safeExec(func() {
+			New(nil).WithOutput(output).Positive(value)
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic for %v", value)
+			t.FailNow()
+		}
+		if len(output.String()) == 0 {
+			t.Errorf("should have written output on failure")
+			t.FailNow()
+		}
+	}
+
+	output := bytes.NewBuffer(nil)
+	_ = safeExec(func() {
+		New(nil).WithOutput(output).Positive(0)
+	})
+	if !strings.Contains(output.String(), "zero is neither positive nor negative") {
+		t.Errorf("should have explained zero in the output, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNegative(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).Negative(-1)           // should be ok
+		New(nil).Negative(int64(-1))    // should be ok
+		New(nil).Negative(-0.001)       // should be ok
+		New(nil).Negative(float32(-10)) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	for _, value := range []interface{}{1, 0, 0.5, math.NaN(), "-1", nil} {
+		output := bytes.NewBuffer(nil)
+		err = safeExec(func() {
+			New(nil).WithOutput(output).Negative(value)
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic for %v", value)
+			t.FailNow()
+		}
+		if len(output.String()) == 0 {
+			t.Errorf("should have written output on failure")
+			t.FailNow()
+		}
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).Negative(uint(1))
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "1 is an unsigned uint, which cannot be negative") {
+		t.Errorf("should have explained unsigned values in the output, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertTimeBefore(t *testing.T) {
+	t1 := time.Date(2020, 03, 02, 12, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Second)
+
+	err := safeExec(func() {
+		New(nil).TimeBefore(t1, t2) // should be ok
+		New(nil).TimeAfter(t2, t1)  // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).TimeBefore(t2, t1)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "2020-03-02T12:00:01Z should be before 2020-03-02T12:00:00Z, difference: 1s") {
+		t.Errorf("should have written both times and the difference, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).TimeAfter(t1, t1)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "2020-03-02T12:00:00Z should be after 2020-03-02T12:00:00Z, difference: 0s") {
+		t.Errorf("should have written both times and the difference, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertTimeEqual(t *testing.T) {
+	now := time.Now()
+	est := time.FixedZone("EST", -5*60*60)
+
+	err := safeExec(func() {
+		New(nil).TimeEqual(now, now.Round(0)) // should be ok; the monotonic clock is stripped
+		New(nil).TimeEqual(now, now.In(est))  // should be ok
+		New(nil).Equal(now, now.Round(0))     // should be ok
+		New(nil).Equal(now, now.In(est))      // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	t1 := time.Date(2020, 03, 02, 12, 0, 0, 0, time.UTC)
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).TimeEqual(t1, t1.Add(-1500*time.Millisecond))
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "2020-03-02T12:00:00Z should equal 2020-03-02T11:59:58.5Z, difference: 1.5s") {
+		t.Errorf("should have written both times and the difference, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	err = safeExec(func() {
+		New(nil).WithOutput(bytes.NewBuffer(nil)).NotEqual(now, now.Round(0))
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+}
+
+func TestAssertSameDay(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+
+	err := safeExec(func() {
+		New(nil).SameDay(time.Date(2020, 03, 02, 0, 0, 0, 0, time.UTC), time.Date(2020, 03, 02, 23, 59, 59, 0, time.UTC)) // should be ok
+		New(nil).SameDay(time.Date(2020, 03, 02, 0, 0, 0, 0, est), time.Date(2020, 03, 02, 23, 0, 0, 0, est))             // should be ok
+		// different locations are compared in utc; both are 2020-03-02 in utc.
+		New(nil).SameDay(time.Date(2020, 03, 01, 23, 30, 0, 0, est), time.Date(2020, 03, 02, 20, 0, 0, 0, time.UTC)) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).SameDay(time.Date(2020, 03, 01, 23, 59, 59, 0, time.UTC), time.Date(2020, 03, 02, 0, 0, 0, 0, time.UTC))
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "should be on the same day in UTC") {
+		t.Errorf("should have written the location in the output, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	// in est, the first time is on 2020-03-01 and the second on 2020-03-02.
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).SameDayIn(time.Date(2020, 03, 01, 23, 30, 0, 0, est), time.Date(2020, 03, 02, 20, 0, 0, 0, time.UTC), est)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "should be on the same day in EST, actual: 2020-03-01 and 2020-03-02") {
+		t.Errorf("should have written the days in the location in the output, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertInTimeDelta(t *testing.T) {
+	t1 := time.Date(2018, 04, 10, 12, 00, 00, 00, time.UTC)
+	t2 := time.Date(2018, 04, 10, 12, 00, 01, 00, time.UTC)
+	t3 := time.Date(2018, 04, 10, 12, 01, 00, 00, time.UTC)
+
+	err := safeExec(func() {
+		New(nil).InTimeDelta(t1, t2, time.Second) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).InTimeDelta(t1, t3, time.Second)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertInTimeDeltaNow(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).InTimeDeltaNow(time.Now().Add(-time.Millisecond), time.Second) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).InTimeDeltaNow(time.Now().Add(-time.Minute), time.Second)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertFileNotExists(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).FileNotExists("not_a_file.go") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).FileNotExists("assert.go")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertFileContains(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).FileContains("assert.go", "package assert") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).FileContains("assert.go", "package not_assert")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "does not contain") {
+		t.Errorf("should have written a content mismatch on failure")
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).FileContains("not_a_file.go", "package assert")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Unable to read file") {
+		t.Errorf("should have written a read error on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertFileContents(t *testing.T) {
+	tempFile, err := ioutil.TempFile("", "assert-file-contents")
+	if err != nil {
+		t.Errorf("should have created a temp file: %v", err)
+		t.FailNow()
+	}
+	defer os.Remove(tempFile.Name())
+	contents := bytes.Repeat([]byte("foo bar baz\n"), 10000)
+	tempFile.Write(contents)
+	tempFile.Close()
+
+	err = safeExec(func() {
+		New(nil).FileContents(tempFile.Name(), contents) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	different := append([]byte{}, contents...)
+	different[len(different)-2] = 'Z'
+	testCases := []struct {
+		Expected []byte
+		Message  string
+	}{
+		{Expected: different, Message: fmt.Sprintf("differs at byte %d (line 10000)", len(different)-2)},
+		{Expected: contents[:len(contents)-1], Message: "is longer than the expected"},
+		{Expected: append(contents, 'a'), Message: "is shorter than expected"},
+	}
+	for _, tc := range testCases {
+		output := bytes.NewBuffer(nil)
+		err = safeExec(func() {
+			New(nil).WithOutput(output).FileContents(tempFile.Name(), tc.Expected)
+		})
+		if err == nil {
+			t.Errorf("should have produced a panic")
+			t.FailNow()
+		}
+		if !strings.Contains(output.String(), tc.Message) {
+			t.Errorf("should have written %q on failure, actual: %s", tc.Message, output.String())
+			t.FailNow()
+		}
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).FileContents("not_a_file.go", contents)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Unable to read file") || !strings.Contains(output.String(), "no such file") {
+		t.Errorf("should have written the read error on failure, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertFileSizeGreaterThan(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).FileSizeGreaterThan("assert.go", 1024) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).FileSizeGreaterThan("assert.go", 1<<30)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "should be larger than") {
+		t.Errorf("should have written the size on failure, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertDirExists(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).DirExists("_examples") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).DirExists("not_a_dir")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Directory doesnt exist") {
+		t.Errorf("Should have written the does not exist message on failure")
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).DirExists("assert.go")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "is a file") {
+		t.Errorf("Should have written the is a file message on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertContains(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).Contains("foo bar", "foo") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).Contains("foo bar", "baz")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertNotContains(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NotContains("foo bar", "buzz") // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NotContains("foo bar", "foo")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertAny(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 1 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 0 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertAnyOfInt(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AnyOfInt([]int{1, 2, 3}, func(v int) bool { return v == 1 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AnyOfInt([]int{1, 2, 3}, func(v int) bool { return v == 0 }) // should not  be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertAnyOfInt64(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AnyOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v == 1 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AnyOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v == 0 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+
+	if !New(nil).NonFatal().AnyOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v == 1 }) {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertAnyOfUint(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AnyOfUint([]uint{1, 2, 3}, func(v uint) bool { return v == 1 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AnyOfUint([]uint{1, 2, 3}, func(v uint) bool { return v == 0 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+
+	if !New(nil).NonFatal().AnyOfUint([]uint{1, 2, 3}, func(v uint) bool { return v == 1 }) {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertAnyOfFloat64(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AnyOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v == 1 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AnyOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v == 0 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertAnyOfString(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AnyOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "foo" }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AnyOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "buzz" }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertAll(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 0 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 1 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertUniqueBy(t *testing.T) {
+	type record struct {
+		ID   string
+		Name string
+	}
+	byID := func(v interface{}) interface{} { return v.(record).ID }
+
+	err := safeExec(func() {
+		New(nil).UniqueBy([]record{{ID: "foo", Name: "a"}, {ID: "bar", Name: "a"}}, byID) // should be ok
+		New(nil).UniqueBy([]record{}, byID)                                               // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic: %v", err)
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).UniqueBy([]record{{ID: "foo"}, {ID: "bar"}, {ID: "foo"}}, byID)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), `Elements at indices 0 and 2 share the key: "foo"`) {
+		t.Errorf("should have written the duplicated key and the indices, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().UniqueBy("foo", byID) {
+		t.Errorf("should have failed for a target that is not a slice")
+		t.FailNow()
+	}
+}
+
+func TestAssertAllIndexed(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AllIndexed([]int{0, 2, 4}, func(i int, v Any) bool { return v.(int) == i*2 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AllIndexed([]int{0, 2, 5}, func(i int, v Any) bool { return v.(int) == i*2 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "index 2") {
+		t.Errorf("should have written the index of the failing element, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertAnyIndexed(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AnyIndexed([3]int{1, 1, 2}, func(i int, v Any) bool { return v.(int) == i }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AnyIndexed([]int{1, 2, 3}, func(i int, v Any) bool { return v.(int) == i }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertNoneIndexed(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NoneIndexed([]string{"b", "c"}, func(i int, v Any) bool { return i == 0 && v.(string) == "a" }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NoneIndexed([]string{"b", "a"}, func(i int, v Any) bool { return i == 1 && v.(string) == "a" }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), `index 1 in target: "a"`) {
+		t.Errorf("should have written the index of the passing element, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertAllOfInt(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AllOfInt([]int{1, 2, 3}, func(v int) bool { return v > 0 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AllOfInt([]int{1, 2, 3}, func(v int) bool { return v > 1 }) // should not  be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertAllOfInt64(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AllOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v > 0 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AllOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v > 1 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+
+	if !New(nil).NonFatal().AllOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v > 0 }) {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertAllOfUint(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AllOfUint([]uint{1, 2, 3}, func(v uint) bool { return v > 0 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AllOfUint([]uint{1, 2, 3}, func(v uint) bool { return v > 1 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+
+	if !New(nil).NonFatal().AllOfUint([]uint{1, 2, 3}, func(v uint) bool { return v > 0 }) {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertAllOfFloat64(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AllOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 0 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AllOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 1 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertAllOfString(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).AllOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return len(v) == 3 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).AllOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "foo" }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertNone(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 3 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 2 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertNoneOfInt(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NoneOfInt([]int{1, 2, 3}, func(v int) bool { return v > 3 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NoneOfInt([]int{1, 2, 3}, func(v int) bool { return v > 2 }) // should not  be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertNoneOfInt64(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NoneOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v > 3 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NoneOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v > 2 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+
+	if !New(nil).NonFatal().NoneOfInt64([]int64{1, 2, 3}, func(v int64) bool { return v > 3 }) {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNoneOfUint(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NoneOfUint([]uint{1, 2, 3}, func(v uint) bool { return v > 3 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NoneOfUint([]uint{1, 2, 3}, func(v uint) bool { return v > 2 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+
+	if !New(nil).NonFatal().NoneOfUint([]uint{1, 2, 3}, func(v uint) bool { return v > 3 }) {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNoneOfFloat64(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NoneOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 3 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NoneOfFloat64([]float64{1, 2, 3}, func(v float64) bool { return v > 2 }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+func TestAssertNoneOfString(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).NoneOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return len(v) == 0 }) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).NoneOfString([]string{"foo", "bar", "baz"}, func(v string) bool { return v == "foo" }) // should not be ok
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("Should have written output on failure")
+		t.FailNow()
+	}
+}
+
+// -----
+// Optional / NotFatal
+// -----
+
+func TestAssertNonFatalNil(t *testing.T) {
+	if !New(nil).NonFatal().Nil(nil) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Nil("foo") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalIsType(t *testing.T) {
+	if !New(nil).NonFatal().IsType("", "foo") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().IsType("", 1) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalImplements(t *testing.T) {
+	if !New(nil).NonFatal().Implements((*io.Writer)(nil), bytes.NewBuffer(nil)) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Implements((*io.Writer)(nil), "foo") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotNil(t *testing.T) {
+	if !New(nil).NonFatal().NotNil("foo") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().NotNil(nil) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalLen(t *testing.T) {
+	if !New(nil).NonFatal().Len("foo", 3) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Len("foo", 4) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalLenAtLeast(t *testing.T) {
+	if !New(nil).NonFatal().LenAtLeast([]int{1, 2}, 1) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().LenAtLeast([]int{1, 2}, 3) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Collection should have length at least 3, actual: 2") {
+		t.Errorf("should have produced output with the bound and the length, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalLenAtMost(t *testing.T) {
+	if !New(nil).NonFatal().LenAtMost([]int{1, 2}, 3) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().LenAtMost([]int{1, 2}, 1) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Collection should have length at most 1, actual: 2") {
+		t.Errorf("should have produced output with the bound and the length, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalChannelLen(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	if !New(nil).NonFatal().ChannelLen(ch, 1) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().ChannelLen(ch, 0) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalReceivesWithin(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	if value, ok := New(nil).NonFatal().ReceivesWithin(ch, time.Second); !ok || value != 1 { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if _, ok := New(nil).WithOutput(output).NonFatal().ReceivesWithin(ch, time.Millisecond); ok {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalDoesNotReceiveWithin(t *testing.T) {
+	ch := make(chan int, 1)
+	if !New(nil).NonFatal().DoesNotReceiveWithin(ch, time.Millisecond) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	ch <- 1
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().DoesNotReceiveWithin(ch, time.Second) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalClosedWithin(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+	if !New(nil).NonFatal().ClosedWithin(ch, time.Second) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().ClosedWithin(make(chan struct{}), time.Millisecond) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotClosed(t *testing.T) {
+	ch := make(chan struct{})
+	if !New(nil).NonFatal().NotClosed(ch) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	close(ch)
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().NotClosed(ch) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalMapContains(t *testing.T) {
+	if !New(nil).NonFatal().MapContains(map[string]int{"foo": 1, "bar": 2}, map[string]int{"foo": 1}) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().MapContains(map[string]int{"foo": 1}, map[string]int{"foo": 2}) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalEmpty(t *testing.T) {
+	if !New(nil).NonFatal().Empty("") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Empty("foo") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotEmpty(t *testing.T) {
+	if !New(nil).NonFatal().NotEmpty("foo") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().NotEmpty("") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalValidUTF8(t *testing.T) {
+	if !New(nil).NonFatal().ValidUTF8([]byte("hello")) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().ValidUTF8([]byte{0xff}) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "byte offset 0") {
+		t.Errorf("should have produced output with the offset, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalASCIIOnly(t *testing.T) {
+	if !New(nil).NonFatal().ASCIIOnly([]byte("hello")) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().ASCIIOnly([]byte{'a', 0x80}) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalContainsFold(t *testing.T) {
+	if !New(nil).NonFatal().ContainsFold("ΣΊΣΥΦΟΣ", "σίσυφος") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().ContainsFold("ışık", "IŞIK") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotContainsFold(t *testing.T) {
+	if !New(nil).NonFatal().NotContainsFold("İstanbul", "istanbul") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().NotContainsFold("ΣΊΣΥΦΟΣ", "σίσυφος") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalHasPrefix(t *testing.T) {
+	if !New(nil).NonFatal().HasPrefix("foobar", "foo") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().HasPrefix("foobar", "Foo") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalHasSuffix(t *testing.T) {
+	if !New(nil).NonFatal().HasSuffix("foobar", "bar") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().HasSuffix("foobar", "BAR") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalBlank(t *testing.T) {
+	if !New(nil).NonFatal().Blank("") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Blank("foo\t") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotBlank(t *testing.T) {
+	if !New(nil).NonFatal().NotBlank("foo") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().NotBlank("\t") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalEqual(t *testing.T) {
+	if !New(nil).NonFatal().Equal("foo", "foo") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Equal("foo", "bar") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalExactEqual(t *testing.T) {
+	if !New(nil).NonFatal().ExactEqual("foo", "foo") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().ExactEqual(1, int64(1)) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalReferenceEqual(t *testing.T) {
+	obj1 := "foo"
+	obj2 := "foo"
+	ref1 := &obj1
+	ref2 := &obj1
+	ref3 := &obj2
+
+	if !New(nil).NonFatal().ReferenceEqual(ref1, ref2) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().ReferenceEqual(ref1, ref3) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalEqualValues(t *testing.T) {
+	if !New(nil).NonFatal().EqualValues(int32(5), int64(5)) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().EqualValues(int64(300), int8(44)) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotEqual(t *testing.T) {
+	if !New(nil).NonFatal().NotEqual("bar", "foo") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().NotEqual("foo", "foo") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalZero(t *testing.T) {
+	if !New(nil).NonFatal().Zero(0) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Zero(1) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalRequiredFieldsSet(t *testing.T) {
+	if !New(nil).NonFatal().RequiredFieldsSet(requiredFieldsTestConfig{Name: "test"}, []string{"Name"}) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().RequiredFieldsSet(requiredFieldsTestConfig{}, []string{"Name", "Port"}) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Name, Port") {
+		t.Errorf("should have named every unset field, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalRequiredFieldsSetPaths(t *testing.T) {
+	cfg := requiredFieldsTestConfig{Tags: []string{"foo"}}
+	if !New(nil).NonFatal().RequiredFieldsSet(cfg, []string{"Tags[0]", "tags"}) { // should be ok
+		t.Errorf("should have resolved indices and field names ignoring case")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().RequiredFieldsSet(cfg, []string{"TLS.CertPath", "Tags[1]"}) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Required fields should be set: TLS.CertPath") {
+		t.Errorf("fields behind nil values should be unset, actual: %s", output.String())
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "should exist on assert.requiredFieldsTestConfig: Tags[1]") {
+		t.Errorf("indices out of range should be unknown, actual: %s", output.String())
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotZero(t *testing.T) {
+	if !New(nil).NonFatal().NotZero(1) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().NotZero(0) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalFieldEqual(t *testing.T) {
+	object := map[string]interface{}{"user": map[string]interface{}{"name": "foo"}}
+	if !New(nil).NonFatal().FieldEqual(object, "user.name", "foo") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().FieldEqual(object, "user.email", "foo") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalFieldNotZero(t *testing.T) {
+	object := map[string]interface{}{"user": map[string]interface{}{"name": "foo", "email": ""}}
+	if !New(nil).NonFatal().FieldNotZero(object, "user.name") { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().FieldNotZero(object, "user.email") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalTrue(t *testing.T) {
+	if !New(nil).NonFatal().True(1 == 1) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().True(1 == 0) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalFalse(t *testing.T) {
+	if !New(nil).NonFatal().False(1 == 0) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().False(1 == 1) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalInDelta(t *testing.T) {
+	if !New(nil).NonFatal().InDelta(1, 2, 1) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().InDelta(1, 3, 1) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalIsNaN(t *testing.T) {
+	if !New(nil).NonFatal().IsNaN(math.NaN()) || !New(nil).NonFatal().NotNaN(0) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().IsNaN(0) || New(nil).WithOutput(output).NonFatal().NotNaN(math.NaN()) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if strings.Count(output.String(), "Assertion Failed!") != 2 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalIsInf(t *testing.T) {
+	if !New(nil).NonFatal().IsInf(math.Inf(1), 0) || !New(nil).NonFatal().NotInf(0, 0) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().IsInf(0, 0) || New(nil).WithOutput(output).NonFatal().NotInf(math.Inf(-1), -1) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if strings.Count(output.String(), "Assertion Failed!") != 2 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNotInDelta(t *testing.T) {
+	if !New(nil).NonFatal().NotInDelta(1, 2, 0.5) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().NotInDelta(1, 1.25, 0.5) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalMapInDelta(t *testing.T) {
+	if !New(nil).NonFatal().MapInDelta(map[string]float64{"a": 1}, map[string]float64{"a": 1.05}, 0.1) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().MapInDelta(map[string]float64{"a": 1}, map[string]float64{"b": 1}, 0.1) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalInEpsilon(t *testing.T) {
+	if !New(nil).NonFatal().InEpsilon(1e9, 1.0001e9, 0.001) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().InEpsilon(1e-9, 2e-9, 0.001) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalWithinPercent(t *testing.T) {
+	if !New(nil).NonFatal().WithinPercent(98, 100, 5) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().WithinPercent(1, 0, 5) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalPositive(t *testing.T) {
+	if !New(nil).NonFatal().Positive(1) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Positive(0) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalNegative(t *testing.T) {
+	if !New(nil).NonFatal().Negative(-1) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().Negative(uint8(0)) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalTimeEqual(t *testing.T) {
+	t1 := time.Date(2020, 03, 02, 12, 0, 0, 0, time.UTC)
+	if !New(nil).NonFatal().TimeEqual(t1, t1.In(time.FixedZone("EST", -5*60*60))) || !New(nil).NonFatal().TimeBefore(t1, t1.Add(1)) || !New(nil).NonFatal().TimeAfter(t1, t1.Add(-1)) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().TimeEqual(t1, t1.Add(1)) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalSameDay(t *testing.T) {
+	if !New(nil).NonFatal().SameDay(time.Date(2020, 03, 02, 1, 0, 0, 0, time.UTC), time.Date(2020, 03, 02, 2, 0, 0, 0, time.UTC)) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().SameDayIn(time.Date(2020, 03, 02, 1, 0, 0, 0, time.UTC), time.Date(2020, 03, 02, 6, 0, 0, 0, time.UTC), time.FixedZone("EST", -5*60*60)) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalInTimeDelta(t *testing.T) {
+	t1 := time.Date(2018, 04, 10, 12, 00, 00, 00, time.UTC)
+	t2 := time.Date(2018, 04, 10, 12, 00, 01, 00, time.UTC)
+	t3 := time.Date(2018, 04, 10, 12, 01, 00, 00, time.UTC)
+
+	if !New(nil).NonFatal().InTimeDelta(t1, t2, time.Second) { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().InTimeDelta(t1, t3, time.Second) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalInTimeDeltaNow(t *testing.T) {
+	if !New(nil).NonFatal().InTimeDeltaNow(time.Now().Add(time.Millisecond), time.Second) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().InTimeDeltaNow(time.Now().Add(time.Minute), time.Second) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalFileNotExists(t *testing.T) {
+	if !New(nil).NonFatal().FileNotExists("not_a_file.go") { // should be ok {
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().FileNotExists("assert.go") {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalFileContents(t *testing.T) {
+	contents, err := ioutil.ReadFile("assert.go")
+	if err != nil {
+		t.Errorf("should have read the file: %v", err)
+		t.FailNow()
+	}
+	if !New(nil).NonFatal().FileContents("assert.go", contents) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().FileContents("assert.go", []byte("package not_assert")) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if len(output.String()) == 0 {
+		t.Errorf("should have produced output")
+		t.FailNow()
+	}
+}
+
+func TestAssertNonFatalFileSizeGreaterThan(t *testing.T) {
+	if !New(nil).NonFatal().FileSizeGreaterThan("assert.go", 1024) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+
+	output := bytes.NewBuffer(nil)
+	if New(nil).WithOutput(output).NonFatal().FileSizeGreaterThan("not_a_file.go", 0) {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1124,14 +3983,14 @@ func TestAssertNonFatalNil(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalNotNil(t *testing.T) {
-	if !New(nil).NonFatal().NotNil("foo") { // should be ok {
+func TestAssertNonFatalFileContains(t *testing.T) {
+	if !New(nil).NonFatal().FileContains("assert.go", "package assert") { // should be ok {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().NotNil(nil) {
+	if New(nil).WithOutput(output).NonFatal().FileContains("assert.go", "package not_assert") {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1141,14 +4000,14 @@ func TestAssertNonFatalNotNil(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalLen(t *testing.T) {
-	if !New(nil).NonFatal().Len("foo", 3) { // should be ok {
+func TestAssertNonFatalDirExists(t *testing.T) {
+	if !New(nil).NonFatal().DirExists("_examples") { // should be ok {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().Len("foo", 4) {
+	if New(nil).WithOutput(output).NonFatal().DirExists("assert.go") {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1158,14 +4017,14 @@ func TestAssertNonFatalLen(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalEmpty(t *testing.T) {
-	if !New(nil).NonFatal().Empty("") { // should be ok {
+func TestAssertNonFatalContains(t *testing.T) {
+	if !New(nil).NonFatal().Contains("foo bar", "bar") { // should be ok {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().Empty("foo") {
+	if New(nil).WithOutput(output).NonFatal().Contains("foo bar", "something") {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1175,14 +4034,14 @@ func TestAssertNonFatalEmpty(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalNotEmpty(t *testing.T) {
-	if !New(nil).NonFatal().NotEmpty("foo") { // should be ok {
+func TestAssertNonFatalNotContains(t *testing.T) {
+	if !New(nil).NonFatal().NotContains("foo bar", "buzz") { // should be ok {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().NotEmpty("") {
+	if New(nil).WithOutput(output).NonFatal().NotContains("foo bar", "bar") {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1192,14 +4051,14 @@ func TestAssertNonFatalNotEmpty(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalEqual(t *testing.T) {
-	if !New(nil).NonFatal().Equal("foo", "foo") { // should be ok {
+func TestAssertNonFatalAny(t *testing.T) {
+	if !New(nil).NonFatal().Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 2 }) { // should be ok {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().Equal("foo", "bar") {
+	if New(nil).WithOutput(output).NonFatal().Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 0 }) {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1209,20 +4068,14 @@ func TestAssertNonFatalEqual(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalReferenceEqual(t *testing.T) {
-	obj1 := "foo"
-	obj2 := "foo"
-	ref1 := &obj1
-	ref2 := &obj1
-	ref3 := &obj2
-
-	if !New(nil).NonFatal().ReferenceEqual(ref1, ref2) { // should be ok {
+func TestAssertNonFatalAll(t *testing.T) {
+	if !New(nil).NonFatal().All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 0 }) { // should be ok {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().ReferenceEqual(ref1, ref3) {
+	if New(nil).WithOutput(output).NonFatal().All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 1 }) {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1232,14 +4085,31 @@ func TestAssertNonFatalReferenceEqual(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalNotEqual(t *testing.T) {
-	if !New(nil).NonFatal().NotEqual("bar", "foo") { // should be ok {
+func TestAssertNonFatalIndexed(t *testing.T) {
+	isDouble := func(i int, v Any) bool { return v.(int) == i*2 }
+	if !New(nil).NonFatal().AllIndexed([]int{0, 2, 4}, isDouble) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if !New(nil).NonFatal().AnyIndexed([]int{1, 2, 4}, isDouble) { // should be ok
+		t.Errorf("should not have failed")
+		t.FailNow()
+	}
+	if !New(nil).NonFatal().NoneIndexed([]int{1, 3, 5}, isDouble) { // should be ok
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().NotEqual("foo", "foo") {
+	if New(nil).WithOutput(output).NonFatal().AllIndexed([]int{0, 3}, isDouble) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(output).NonFatal().AnyIndexed([]int{1, 3}, isDouble) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	if New(nil).WithOutput(output).NonFatal().NoneIndexed([]int{0, 3}, isDouble) {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1249,14 +4119,14 @@ func TestAssertNonFatalNotEqual(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalZero(t *testing.T) {
-	if !New(nil).NonFatal().Zero(0) { // should be ok {
+func TestAssertNonFatalNone(t *testing.T) {
+	if !New(nil).NonFatal().None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 3 }) { // should be ok {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().Zero(1) {
+	if New(nil).WithOutput(output).NonFatal().None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 2 }) {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1266,31 +4136,54 @@ func TestAssertNonFatalZero(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalNotZero(t *testing.T) {
-	if !New(nil).NonFatal().NotZero(1) { // should be ok {
-		t.Errorf("should not have failed")
+func panicsInNamedFunction() {
+	panic("this is only a test")
+}
+
+func TestAssertPanicStackContains(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).PanicStackContains("assert.panicsInNamedFunction", panicsInNamedFunction) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().NotZero(0) {
-		t.Errorf("should have failed")
+	err = safeExec(func() {
+		New(nil).WithOutput(output).PanicStackContains("assert.notTheFunction", panicsInNamedFunction)
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+	if !strings.Contains(output.String(), "Panic stack should contain `assert.notTheFunction`, panic: this is only a test") {
+		t.Errorf("should have written the substring and panic, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	err = safeExec(func() {
+		New(nil).WithOutput(output).PanicStackContains("assert.panicsInNamedFunction", func() {})
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Should have produced a panic") {
+		t.Errorf("should have written that there was no panic, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertNonFatalTrue(t *testing.T) {
-	if !New(nil).NonFatal().True(1 == 1) { // should be ok {
+func TestAssertNonFatalPanicStackContains(t *testing.T) {
+	if !New(nil).NonFatal().PanicStackContains("panicsInNamedFunction", panicsInNamedFunction) { // should be ok
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().True(1 == 0) {
+	if New(nil).WithOutput(output).NonFatal().PanicStackContains("panicsInNamedFunction", func() { panic("foo") }) {
 		t.Errorf("should have failed")
 		t.FailNow()
 	}
@@ -1300,163 +4193,369 @@ func TestAssertNonFatalTrue(t *testing.T) {
 	}
 }
 
-func TestAssertNonFatalFalse(t *testing.T) {
-	if !New(nil).NonFatal().False(1 == 0) { // should be ok {
+func TestAssertNonFatalPanicEqual(t *testing.T) {
+	if !New(nil).NonFatal().PanicEqual("this is only a test", func() {
+		panic("this is only a test")
+	}) {
 		t.Errorf("should not have failed")
 		t.FailNow()
 	}
 
+	if New(nil).NonFatal().PanicEqual("this is only a test", func() {}) {
+		t.Errorf("should have failed without a panic triggered")
+		t.FailNow()
+	}
+
+	if New(nil).NonFatal().PanicEqual("this is only a test", func() {
+		panic("not what we want")
+	}) {
+		t.Errorf("should have failed on a wrong panic result")
+		t.FailNow()
+	}
+}
+
+func panicWith(value interface{}) {
+	panic(value)
+}
+
+func TestAssertPanicEqualStack(t *testing.T) {
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().False(1 == 1) {
-		t.Errorf("should have failed")
+	New(nil).WithOutput(output).NonFatal().PanicEqual("this is only a test", func() {
+		panicWith("not what we want")
+	})
+	if !strings.Contains(output.String(), "Stack") || !strings.Contains(output.String(), "assert.panicWith(") {
+		t.Errorf("should have written the stack of the panic's origin, actual: %s", output.String())
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+	if strings.Contains(output.String(), "assert.shouldBePanicEqual") || strings.Contains(output.String(), "runtime/debug.Stack") {
+		t.Errorf("should have trimmed the frames of the assert package, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	New(nil).WithOutput(output).NonFatal().PanicEqual("this is only a test", func() {})
+	if !strings.Contains(output.String(), "Should have produced a panic") || strings.Contains(output.String(), "Stack") {
+		t.Errorf("should not have written a stack without a panic, actual: %s", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertNonFatalInDelta(t *testing.T) {
-	if !New(nil).NonFatal().InDelta(1, 2, 1) { // should be ok {
-		t.Errorf("should not have failed")
+func TestAssertWithTimeout(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).WithTimeout(time.Second, func(ctx context.Context) {
+			New(nil).WithTimeout(time.Second, func(ctx context.Context) {}) // should be ok nested
+		})
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().InDelta(1, 3, 1) {
-		t.Errorf("should have failed")
+	err = safeExec(func() {
+		New(nil).WithOutput(output).WithTimeout(time.Millisecond, func(ctx context.Context) {
+			<-ctx.Done()
+		})
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+	if !strings.Contains(output.String(), "Timeout Reached") {
+		t.Errorf("should have written a timeout on failure")
 		t.FailNow()
 	}
 }
 
-func TestAssertNonFatalInTimeDelta(t *testing.T) {
-	t1 := time.Date(2018, 04, 10, 12, 00, 00, 00, time.UTC)
-	t2 := time.Date(2018, 04, 10, 12, 00, 01, 00, time.UTC)
-	t3 := time.Date(2018, 04, 10, 12, 01, 00, 00, time.UTC)
-
-	if !New(nil).NonFatal().InTimeDelta(t1, t2, time.Second) { // should be ok {
-		t.Errorf("should not have failed")
+func TestAssertWithTimeoutFailureDoesNotLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for x := 0; x < 10; x++ {
+		safeExec(func() {
+			a := New(nil).WithOutput(bytes.NewBuffer(nil))
+			a.WithTimeout(time.Minute, func(ctx context.Context) {
+				a.True(false) // fail within the timed block
+			})
+		})
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("should not have leaked goroutines, before: %d, after: %d", before, after)
 		t.FailNow()
 	}
+}
 
-	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().InTimeDelta(t1, t3, time.Second) {
-		t.Errorf("should have failed")
+func TestAssertStartTimeoutNested(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	a := New(nil)
+	a.StartTimeout(time.Minute)
+	a.StartTimeout(time.Minute)
+	a.EndTimeout()
+	a.EndTimeout()
+	a.EndTimeout() // should be a no-op
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("should not have leaked goroutines, before: %d, after: %d", before, after)
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+
+	output := bytes.NewBuffer(nil)
+	a = New(nil).WithOutput(output)
+	a.StartTimeout(time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	a.EndTimeout()
+	if !strings.Contains(output.String(), "Timeout Reached") {
+		t.Errorf("should have written a timeout")
 		t.FailNow()
 	}
 }
 
-func TestAssertNonFatalContains(t *testing.T) {
-	if !New(nil).NonFatal().Contains("foo bar", "bar") { // should be ok {
-		t.Errorf("should not have failed")
-		t.FailNow()
+func TestIsNil(t *testing.T) {
+	var nilFunc func()
+	var nilMap map[string]int
+	var nilChan chan int
+	var nilInterface error
+	var nilPtr *bytes.Buffer
+	var nilSlice []int
+
+	for _, value := range []interface{}{nil, nilFunc, nilMap, nilChan, nilInterface, nilPtr, nilSlice} {
+		if !isNil(value) {
+			t.Errorf("should be nil: %#v", value)
+			t.FailNow()
+		}
+	}
+
+	for _, value := range []interface{}{func() {}, map[string]int{}, make(chan int), bytes.NewBuffer(nil), []int{}, 0, "", struct{}{}} {
+		if isNil(value) {
+			t.Errorf("should not be nil: %#v", value)
+			t.FailNow()
+		}
 	}
+}
 
+func TestAssertWithColor(t *testing.T) {
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().Contains("foo bar", "something") {
-		t.Errorf("should have failed")
+	safeExec(func() {
+		New(nil).WithOutput(output).Equal(1, 2)
+	})
+	if !strings.Contains(output.String(), "\033[") {
+		t.Errorf("should have colored output by default")
 		t.FailNow()
 	}
+
+	output = bytes.NewBuffer(nil)
+	safeExec(func() {
+		New(nil).WithOutput(output).WithColor(false).Equal(1, 2)
+	})
 	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+		t.Errorf("should have written output on failure")
+		t.FailNow()
+	}
+	if strings.Contains(output.String(), "\033[") {
+		t.Errorf("should not have colored output, actual: %q", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	New(nil).WithOutput(output).WithColor(false).NonFatal().Equal(1, 2)
+	if strings.Contains(output.String(), "\033[") {
+		t.Errorf("non-fatal assertions should inherit the color setting, actual: %q", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	New(nil).WithOutput(output).NonFatal().WithColor(false).Equal(1, 2)
+	if strings.Contains(output.String(), "\033[") {
+		t.Errorf("should not have colored output, actual: %q", output.String())
 		t.FailNow()
 	}
 }
 
-func TestAssertNonFatalNotContains(t *testing.T) {
-	if !New(nil).NonFatal().NotContains("foo bar", "buzz") { // should be ok {
-		t.Errorf("should not have failed")
+func TestAssertUseColorFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "assert")
+	if err != nil {
+		t.Errorf("should have created a temp file: %v", err)
 		t.FailNow()
 	}
+	defer os.Remove(file.Name())
+	defer file.Close()
 
-	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().NotContains("foo bar", "bar") {
-		t.Errorf("should have failed")
+	if New(nil).WithOutput(file).UseColor() {
+		t.Errorf("should not use color for a file that is not a terminal")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+	if !New(nil).WithOutput(file).WithColor(true).UseColor() {
+		t.Errorf("should use color when explicitly enabled")
 		t.FailNow()
 	}
 }
 
-func TestAssertNonFatalAny(t *testing.T) {
-	if !New(nil).NonFatal().Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 2 }) { // should be ok {
-		t.Errorf("should not have failed")
+func TestStripColor(t *testing.T) {
+	if actual := stripColor(color("foo", RED) + " bar " + color("baz", GRAY)); actual != "foo bar baz" {
+		t.Errorf("should have stripped color codes, actual: %q", actual)
+		t.FailNow()
+	}
+}
+
+func TestAssertRequire(t *testing.T) {
+	err := safeExec(func() {
+		New(nil).Require().True(true) // should be ok
+	})
+	if err != nil {
+		t.Errorf("should not have produced a panic")
 		t.FailNow()
 	}
 
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().Any([]int{1, 2, 3}, func(v Any) bool { return v.(int) == 0 }) {
-		t.Errorf("should have failed")
+	var recovered interface{}
+	func() {
+		defer func() {
+			recovered = recover()
+		}()
+		New(nil).WithOutput(output).WithColor(false).Require().Equal(1, 2, "setup")
+	}()
+	if recovered == nil {
+		t.Errorf("should have produced a panic")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+	asError, isError := recovered.(error)
+	if !isError {
+		t.Errorf("should have panicked with an error, actual: %#v", recovered)
+		t.FailNow()
+	}
+	if !strings.HasPrefix(asError.Error(), "Objects should be equal") || !strings.HasSuffix(asError.Error(), ": setup") {
+		t.Errorf("should have panicked with the failure message, actual: %s", asError.Error())
+		t.FailNow()
+	}
+	if !strings.Contains(output.String(), "Objects should be equal") {
+		t.Errorf("should have written the failure, actual: %s", output.String())
 		t.FailNow()
 	}
-}
 
-func TestAssertNonFatalAll(t *testing.T) {
-	if !New(nil).NonFatal().All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 0 }) { // should be ok {
-		t.Errorf("should not have failed")
+	done := make(chan interface{})
+	go func() {
+		defer func() {
+			done <- recover()
+		}()
+		New(nil).Require().NotNil(nil)
+	}()
+	if <-done == nil {
+		t.Errorf("should have produced a panic in a goroutine")
 		t.FailNow()
 	}
+}
 
+func TestAssertRun(t *testing.T) {
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().All([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 1 }) {
-		t.Errorf("should have failed")
+	parent := New(t).WithOutput(output).WithColor(false).WithDiff(true)
+
+	var child *Assertions
+	if !parent.Run("child", func(a *Assertions) {
+		child = a
+		a.True(true)
+	}) {
+		t.Errorf("the subtest should have passed")
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+	if child == nil || child == parent {
+		t.Errorf("the subtest should have been given new assertions")
+		t.FailNow()
+	}
+	if child.t == t || child.t == nil {
+		t.Errorf("the subtest assertions should be bound to the subtest")
+		t.FailNow()
+	}
+	if child.Output() != output || child.UseColor() || !child.diff {
+		t.Errorf("the subtest assertions should inherit the parent settings")
 		t.FailNow()
 	}
-}
 
-func TestAssertNonFatalNone(t *testing.T) {
-	if !New(nil).NonFatal().None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 3 }) { // should be ok {
-		t.Errorf("should not have failed")
+	var ran bool
+	err := safeExec(func() {
+		New(nil).WithOutput(output).Run("child", func(a *Assertions) {
+			ran = true
+			if a.Output() != output {
+				t.Errorf("the assertions should inherit the parent settings")
+			}
+		})
+	})
+	if err != nil || !ran {
+		t.Errorf("the body should have been called directly without a test")
 		t.FailNow()
 	}
+}
 
+func TestAssertNonFatalReport(t *testing.T) {
 	output := bytes.NewBuffer(nil)
-	if New(nil).WithOutput(output).NonFatal().None([]int{1, 2, 3}, func(v Any) bool { return v.(int) > 2 }) {
-		t.Errorf("should have failed")
+	o := New(nil).WithOutput(output).WithColor(false).NonFatal().WithRecordFailures(true)
+
+	o.True(true)
+	o.Equal(1, 2, "numbers")
+	o.Fail("explicit")
+	if len(output.String()) != 0 {
+		t.Errorf("should not have written failures as they happened, actual: %s", output.String())
 		t.FailNow()
 	}
-	if len(output.String()) == 0 {
-		t.Errorf("should have produced output")
+
+	failures := o.Failures()
+	if len(failures) != 2 {
+		t.Errorf("should have recorded 2 failures, actual: %d", len(failures))
+		t.FailNow()
+	}
+	if failures[0].UserMessage != "numbers" || failures[1].UserMessage != "explicit" {
+		t.Errorf("should have recorded the user messages, actual: %#v", failures)
 		t.FailNow()
 	}
-}
 
-func TestAssertNonFatalPanicEqual(t *testing.T) {
-	if !New(nil).NonFatal().PanicEqual("this is only a test", func() {
-		panic("this is only a test")
-	}) {
-		t.Errorf("should not have failed")
+	if o.Report() {
+		t.Errorf("report should have failed")
+		t.FailNow()
+	}
+	report := output.String()
+	if !strings.HasPrefix(report, "Assertion Report: 2 checks, 2 failed\n") {
+		t.Errorf("should have written the totals, actual: %s", report)
+		t.FailNow()
+	}
+	if !strings.Contains(report, "1) Unknown\n\t\t(Non-Fatal) Objects should be equal") || !strings.Contains(report, "Message: numbers") {
+		t.Errorf("should have written the first failure, actual: %s", report)
+		t.FailNow()
+	}
+	if !strings.Contains(report, "2) Unknown\n\t\t(Non-Fatal) Assertion Failed\n\t\tMessage: explicit") {
+		t.Errorf("should have written the second failure, actual: %s", report)
 		t.FailNow()
 	}
 
-	if New(nil).NonFatal().PanicEqual("this is only a test", func() {}) {
-		t.Errorf("should have failed without a panic triggered")
+	output = bytes.NewBuffer(nil)
+	o = New(nil).WithOutput(output).WithColor(false).NonFatal().WithRecordFailures(true)
+	o.True(true)
+	if !o.Report() {
+		t.Errorf("report should not have failed")
 		t.FailNow()
 	}
+	if output.String() != "Assertion Report: 1 checks, 0 failed\n" {
+		t.Errorf("should have written the totals, actual: %s", output.String())
+		t.FailNow()
+	}
+}
 
-	if New(nil).NonFatal().PanicEqual("this is only a test", func() {
-		panic("not what we want")
-	}) {
-		t.Errorf("should have failed on a wrong panic result")
+func TestAssertNonFatalReportParallel(t *testing.T) {
+	o := New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().WithRecordFailures(true)
+
+	wg := sync.WaitGroup{}
+	for x := 0; x < 16; x++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			o.True(index%2 == 0)
+		}(x)
+	}
+	wg.Wait()
+
+	if failures := o.Failures(); len(failures) != 8 {
+		t.Errorf("should have recorded 8 failures, actual: %d", len(failures))
+		t.FailNow()
+	}
+	if o.Report() {
+		t.Errorf("report should have failed")
 		t.FailNow()
 	}
 }
@@ -0,0 +1,92 @@
+package assert
+
+import (
+	"fmt"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+var (
+	callerExclusionsLock sync.Mutex
+	callerExclusions     = []string{"assert", "go-assert", "mock", "require"}
+)
+
+// AddCallerExclusion adds a pattern for frames that are skipped when reporting where an assertion failed.
+// It is useful for packages that wrap the assertions, so failures are reported in the test instead of the wrapper.
+// The pattern is matched with `path.Match` against the trailing directories of a frame's file, e.g.
+// `testutil` matches any file in a `testutil` directory, and `example/testutil` only those in `example/testutil`.
+func AddCallerExclusion(pattern string) {
+	callerExclusionsLock.Lock()
+	defer callerExclusionsLock.Unlock()
+	callerExclusions = append(callerExclusions, pattern)
+}
+
+// isCallerExcluded returns if a frame in a given directory should be skipped.
+func isCallerExcluded(dir string) bool {
+	callerExclusionsLock.Lock()
+	defer callerExclusionsLock.Unlock()
+
+	segments := strings.Split(dir, "/")
+	for _, pattern := range callerExclusions {
+		count := strings.Count(pattern, "/") + 1
+		if count > len(segments) {
+			continue
+		}
+		if matched, _ := path.Match(pattern, strings.Join(segments[len(segments)-count:], "/")); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// callerOptions are the options for reporting where an assertion failed.
+type callerOptions struct {
+	skip      int
+	fullPaths bool
+}
+
+// callerInfo returns the locations of the frames that led to an assertion, innermost first.
+// It stops at the test, benchmark or example function, or at the testing package for subtests.
+func callerInfo(options callerOptions) []string {
+	var callers []string
+	for i := 1; ; i++ {
+		pc, file, line, ok := runtime.Caller(i)
+		if !ok || file == "<autogenerated>" {
+			break
+		}
+
+		f := runtime.FuncForPC(pc)
+		if f == nil {
+			break
+		}
+		name := f.Name()
+
+		// Stop at the test runner, e.g. for the closures of `t.Run`.
+		if strings.HasPrefix(name, "testing.") {
+			break
+		}
+
+		if !isCallerExcluded(path.Dir(file)) {
+			if options.skip > 0 {
+				options.skip--
+			} else if options.fullPaths {
+				callers = append(callers, fmt.Sprintf("%s:%d", file, line))
+			} else {
+				callers = append(callers, fmt.Sprintf("%s:%d", path.Base(file), line))
+			}
+		}
+
+		// Drop the package
+		segments := strings.Split(name, ".")
+		name = segments[len(segments)-1]
+		if isTest(name, "Test") ||
+			isTest(name, "Benchmark") ||
+			isTest(name, "Example") {
+			break
+		}
+	}
+
+	return callers
+}
@@ -0,0 +1,110 @@
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// setCallerExclusions sets the caller exclusions, returning a func that restores the previous exclusions.
+// The files in this package are excluded by default, so tests need to clear the exclusions to see any locations.
+func setCallerExclusions(exclusions []string) func() {
+	callerExclusionsLock.Lock()
+	defer callerExclusionsLock.Unlock()
+	previous := callerExclusions
+	callerExclusions = exclusions
+	return func() {
+		callerExclusionsLock.Lock()
+		defer callerExclusionsLock.Unlock()
+		callerExclusions = previous
+	}
+}
+
+func callerInfoHelper(options callerOptions) []string {
+	return callerInfo(options)
+}
+
+func TestCallerInfo(t *testing.T) {
+	defer setCallerExclusions(nil)()
+	callers := callerInfoHelper(callerOptions{})
+	if len(callers) != 2 {
+		t.Errorf("should have returned the helper and the test, actual: %v", callers)
+		t.FailNow()
+	}
+	for _, caller := range callers {
+		if !strings.HasPrefix(caller, "caller_test.go:") {
+			t.Errorf("should have returned the file name, actual: %v", callers)
+			t.FailNow()
+		}
+	}
+
+	skipped := callerInfoHelper(callerOptions{skip: 1})
+	if len(skipped) != 1 || skipped[0] == callers[0] {
+		t.Errorf("should have skipped the helper, actual: %v", skipped)
+		t.FailNow()
+	}
+
+	full := callerInfoHelper(callerOptions{fullPaths: true})
+	if len(full) != 2 || !strings.HasSuffix(full[0], "/assert/"+callers[0]) {
+		t.Errorf("should have returned the full path, actual: %v", full)
+		t.FailNow()
+	}
+}
+
+func TestCallerInfoSubtest(t *testing.T) {
+	defer setCallerExclusions(nil)()
+	t.Run("subtest", func(t *testing.T) {
+		callers := callerInfo(callerOptions{})
+		if len(callers) != 1 || !strings.HasPrefix(callers[0], "caller_test.go:") {
+			t.Errorf("should have stopped at the subtest, actual: %v", callers)
+			t.FailNow()
+		}
+	})
+}
+
+func TestCallerInfoExcluded(t *testing.T) {
+	callers := callerInfo(callerOptions{})
+	if len(callers) != 0 {
+		t.Errorf("should have excluded the assert package, actual: %v", callers)
+		t.FailNow()
+	}
+}
+
+func TestAddCallerExclusion(t *testing.T) {
+	defer setCallerExclusions(nil)()
+	AddCallerExclusion("testutil")
+	AddCallerExclusion("example/helpers*")
+
+	if !isCallerExcluded("/go/src/github.com/example/testutil") {
+		t.Errorf("should have excluded a matching directory name")
+		t.FailNow()
+	}
+	if !isCallerExcluded("/go/src/github.com/example/helpersv2") {
+		t.Errorf("should have excluded a matching directory path")
+		t.FailNow()
+	}
+	if isCallerExcluded("/go/src/github.com/other/helpers") {
+		t.Errorf("should not have excluded a directory with a different parent")
+		t.FailNow()
+	}
+	if isCallerExcluded("/go/src/github.com/example/testutil/nested") {
+		t.Errorf("should not have excluded a nested directory")
+		t.FailNow()
+	}
+}
+
+func TestAssertWithCallerOptions(t *testing.T) {
+	a := New(nil).WithCallerSkip(1).WithFullCallerPaths(true)
+	if a.CallerSkip() != 1 || !a.FullCallerPaths() {
+		t.Errorf("should have set the caller options")
+		t.FailNow()
+	}
+
+	defer setCallerExclusions(nil)()
+	output := bytes.NewBuffer(nil)
+	New(nil).WithOutput(output).WithFullCallerPaths(true).NonFatal().True(false)
+	if !strings.Contains(output.String(), "/assert/caller_test.go:") {
+		t.Errorf("should have written the full path of the failure location, actual: %s", output.String())
+		t.FailNow()
+	}
+}
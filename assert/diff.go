@@ -0,0 +1,149 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// diffMaxLines is the maximum number of differences written to a diff.
+const diffMaxLines = 50
+
+// diffMessage returns a description of the fields that differ between two objects.
+func diffMessage(expected, actual interface{}) string {
+	diffs := diff(expected, actual)
+	if len(diffs) == 0 {
+		return EMPTY
+	}
+	if len(diffs) > diffMaxLines {
+		diffs = append(diffs[:diffMaxLines], fmt.Sprintf("... and %d more", len(diffs)-diffMaxLines))
+	}
+	return fmt.Sprintf("%s:\n\t%s", color("Diff", WHITE), strings.Join(diffs, "\n\t"))
+}
+
+// withDiff appends a diff of two objects to a failure message, if there are differences to show.
+func withDiff(message string, expected, actual interface{}) string {
+	if diff := diffMessage(expected, actual); diff != EMPTY {
+		return message + "\n" + diff
+	}
+	return message
+}
+
+// diff walks two objects and returns a line for each path where they differ.
+func diff(expected, actual interface{}) []string {
+	var output []string
+	diffValues("", reflect.ValueOf(expected), reflect.ValueOf(actual), map[[2]uintptr]bool{}, &output)
+	return output
+}
+
+func diffValues(path string, expected, actual reflect.Value, visited map[[2]uintptr]bool, output *[]string) {
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() != actual.IsValid() {
+			*output = append(*output, diffLine(path, expected, actual))
+		}
+		return
+	}
+	if expected.Type() != actual.Type() {
+		*output = append(*output, fmt.Sprintf("%s: expected type: %v, actual type: %v", diffPath(path), expected.Type(), actual.Type()))
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				*output = append(*output, diffLine(path, expected, actual))
+			}
+			return
+		}
+		if expected.Kind() == reflect.Ptr {
+			// guard against cycles
+			key := [2]uintptr{expected.Pointer(), actual.Pointer()}
+			if visited[key] {
+				return
+			}
+			visited[key] = true
+		}
+		diffValues(path, expected.Elem(), actual.Elem(), visited, output)
+	case reflect.Struct:
+		for index := 0; index < expected.NumField(); index++ {
+			fieldPath := path + "." + expected.Type().Field(index).Name
+			diffValues(fieldPath, expected.Field(index), actual.Field(index), visited, output)
+		}
+	case reflect.Slice, reflect.Array:
+		if expected.Kind() == reflect.Slice && (expected.IsNil() != actual.IsNil()) {
+			*output = append(*output, diffLine(path, expected, actual))
+			return
+		}
+		if expected.Len() != actual.Len() {
+			*output = append(*output, fmt.Sprintf("%s: expected length: %d, actual length: %d", diffPath(path), expected.Len(), actual.Len()))
+		}
+		for index := 0; index < expected.Len() || index < actual.Len(); index++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, index)
+			if index >= expected.Len() {
+				*output = append(*output, fmt.Sprintf("%s: unexpected: %s", elemPath, diffValue(actual.Index(index))))
+			} else if index >= actual.Len() {
+				*output = append(*output, fmt.Sprintf("%s: missing: %s", elemPath, diffValue(expected.Index(index))))
+			} else {
+				diffValues(elemPath, expected.Index(index), actual.Index(index), visited, output)
+			}
+		}
+	case reflect.Map:
+		if expected.IsNil() != actual.IsNil() {
+			*output = append(*output, diffLine(path, expected, actual))
+			return
+		}
+		keys := map[string]reflect.Value{}
+		for _, key := range expected.MapKeys() {
+			keys[diffValue(key)] = key
+		}
+		for _, key := range actual.MapKeys() {
+			keys[diffValue(key)] = key
+		}
+		var sortedKeys []string
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+		for _, keyName := range sortedKeys {
+			key := keys[keyName]
+			elemPath := fmt.Sprintf("%s[%s]", path, keyName)
+			expectedElem, actualElem := expected.MapIndex(key), actual.MapIndex(key)
+			if !expectedElem.IsValid() {
+				*output = append(*output, fmt.Sprintf("%s: unexpected: %s", elemPath, diffValue(actualElem)))
+			} else if !actualElem.IsValid() {
+				*output = append(*output, fmt.Sprintf("%s: missing: %s", elemPath, diffValue(expectedElem)))
+			} else {
+				diffValues(elemPath, expectedElem, actualElem, visited, output)
+			}
+		}
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if expected.Pointer() != actual.Pointer() {
+			*output = append(*output, diffLine(path, expected, actual))
+		}
+	default:
+		if diffValue(expected) != diffValue(actual) {
+			*output = append(*output, diffLine(path, expected, actual))
+		}
+	}
+}
+
+func diffLine(path string, expected, actual reflect.Value) string {
+	return fmt.Sprintf("%s: expected: %s, actual: %s", diffPath(path), diffValue(expected), diffValue(actual))
+}
+
+func diffPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+// diffValue formats a value; it uses the reflect value directly so unexported fields can be printed.
+func diffValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%#v", value)
+}
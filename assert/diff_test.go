@@ -0,0 +1,104 @@
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type diffTestObject struct {
+	ID       int
+	Name     string
+	Tags     []string
+	Labels   map[string]string
+	Child    *diffTestObject
+	internal string
+}
+
+func TestDiff(t *testing.T) {
+	expected := diffTestObject{
+		ID:       1,
+		Name:     "foo",
+		Tags:     []string{"a", "b"},
+		Labels:   map[string]string{"env": "dev", "team": "core"},
+		Child:    &diffTestObject{ID: 2, Name: "child"},
+		internal: "secret",
+	}
+	actual := expected
+	actual.Name = "bar"
+	actual.Tags = []string{"a", "c", "d"}
+	actual.Labels = map[string]string{"env": "prod"}
+	actual.Child = &diffTestObject{ID: 3, Name: "child"}
+	actual.internal = "other"
+
+	diffs := diff(expected, actual)
+	expectedDiffs := []string{
+		`.Name: expected: "foo", actual: "bar"`,
+		`.Tags: expected length: 2, actual length: 3`,
+		`.Tags[1]: expected: "b", actual: "c"`,
+		`.Tags[2]: unexpected: "d"`,
+		`.Labels["team"]: missing: "core"`,
+		`.Labels["env"]: expected: "dev", actual: "prod"`,
+		`.Child.ID: expected: 2, actual: 3`,
+		`.internal: expected: "secret", actual: "other"`,
+	}
+	if len(diffs) != len(expectedDiffs) {
+		t.Errorf("should have found %d differences, actual: %v", len(expectedDiffs), diffs)
+		t.FailNow()
+	}
+	joined := strings.Join(diffs, "\n")
+	for _, expectedDiff := range expectedDiffs {
+		if !strings.Contains(joined, expectedDiff) {
+			t.Errorf("should have found difference %q, actual: %v", expectedDiff, diffs)
+			t.FailNow()
+		}
+	}
+
+	cyclic := &diffTestObject{ID: 1}
+	cyclic.Child = cyclic
+	otherCyclic := &diffTestObject{ID: 1}
+	otherCyclic.Child = otherCyclic
+	if diffs := diff(cyclic, otherCyclic); len(diffs) != 0 {
+		t.Errorf("should not have found differences in cyclic objects, actual: %v", diffs)
+		t.FailNow()
+	}
+
+	if diffs := diff(expected, expected); len(diffs) != 0 {
+		t.Errorf("should not have found differences, actual: %v", diffs)
+		t.FailNow()
+	}
+}
+
+func TestAssertEqualWithDiff(t *testing.T) {
+	expected := diffTestObject{ID: 1, Name: "foo"}
+	actual := diffTestObject{ID: 1, Name: "bar"}
+
+	output := bytes.NewBuffer(nil)
+	safeExec(func() {
+		New(nil).WithOutput(output).Equal(expected, actual)
+	})
+	if strings.Contains(output.String(), "Diff") {
+		t.Errorf("should not have written a diff by default")
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	safeExec(func() {
+		New(nil).WithOutput(output).WithDiff(true).Equal(expected, actual)
+	})
+	if !strings.Contains(output.String(), `.Name: expected: "foo", actual: "bar"`) {
+		t.Errorf("should have written a diff, actual: %s", output.String())
+		t.FailNow()
+	}
+	if strings.Contains(output.String(), ".ID") {
+		t.Errorf("should have only written the differing fields, actual: %s", output.String())
+		t.FailNow()
+	}
+
+	output = bytes.NewBuffer(nil)
+	New(nil).WithOutput(output).WithDiff(true).NonFatal().Equal(expected, actual)
+	if !strings.Contains(output.String(), `.Name: expected: "foo", actual: "bar"`) {
+		t.Errorf("should have written a diff, actual: %s", output.String())
+		t.FailNow()
+	}
+}
@@ -0,0 +1,720 @@
+package assert
+
+import "time"
+
+// NewErrored returns a new instance of `Errored`.
+func NewErrored() *Errored {
+	return &Errored{}
+}
+
+// Errored is an assertion type whose assertions return an error describing the failure,
+// instead of failing a test or panicking. It is useful for checking invariants outside of tests, e.g.
+//
+//	if err := assert.NewErrored().NotNil(cfg, "config is required"); err != nil {
+//		return err
+//	}
+//
+// The error message is the assertion failure message without ansi color codes,
+// followed by the user message if one is given.
+type Errored struct{}
+
+// error returns the error for a failure.
+func (e *Errored) error(message string, userMessageComponents ...interface{}) error {
+	return failureError(message, userMessageComponents...)
+}
+
+// Nil asserts the object is nil.
+func (e *Errored) Nil(object interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeNil(object); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotNil asserts the object is not nil.
+func (e *Errored) NotNil(object interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeNil(object); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// IsType asserts that an object has the same type as an exemplar, e.g. `IsType(Config{}, actual)`.
+func (e *Errored) IsType(expected, actual interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeType(expected, actual); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Implements asserts that an object implements an interface, given as a
+// nil pointer to the interface, e.g. `Implements((*io.Reader)(nil), actual)`.
+func (e *Errored) Implements(interfaceObject, actual interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldImplement(interfaceObject, actual); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Len asserts that the collection has a specified length.
+func (e *Errored) Len(collection interface{}, length int, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveLength(collection, length); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// LenAtLeast asserts that a collection has at least a given length.
+func (e *Errored) LenAtLeast(collection interface{}, min int, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveLengthAtLeast(collection, min); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// LenAtMost asserts that a collection has at most a given length.
+func (e *Errored) LenAtMost(collection interface{}, max int, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveLengthAtMost(collection, max); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// ChannelLen asserts that a channel has a given number of buffered items.
+func (e *Errored) ChannelLen(ch interface{}, expected int, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveChannelLength(ch, expected); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// DoesNotReceiveWithin asserts that no value is received on a channel within a timeout.
+// A closed channel fails the assertion.
+func (e *Errored) DoesNotReceiveWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotReceiveWithin(ch, timeout); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// ClosedWithin asserts that a channel is closed within a timeout.
+// Receiving a value before the channel closes fails the assertion.
+func (e *Errored) ClosedWithin(ch interface{}, timeout time.Duration, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeClosedWithin(ch, timeout); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotClosed asserts that a channel is not closed, without blocking.
+// If the channel has a buffered value, the value is consumed.
+func (e *Errored) NotClosed(ch interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeClosed(ch); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// MapContains asserts that a map contains every key in the expected entries
+// with a value equal to the expected value; the superset can have other keys.
+func (e *Errored) MapContains(superset, expectedEntries interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldMapContain(superset, expectedEntries); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Empty asserts that a collection is empty.
+func (e *Errored) Empty(collection interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeEmpty(collection); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotEmpty asserts that a collection is not empty.
+func (e *Errored) NotEmpty(collection interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeEmpty(collection); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// ValidUTF8 asserts that a byte slice is valid UTF-8.
+func (e *Errored) ValidUTF8(b []byte, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeValidUTF8(b); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// ASCIIOnly asserts that a byte slice only contains ASCII characters.
+func (e *Errored) ASCIIOnly(b []byte, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeASCIIOnly(b); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Blank asserts that a string is empty or only contains whitespace.
+func (e *Errored) Blank(value string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeBlank(value); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotBlank asserts that a string contains non-whitespace characters.
+func (e *Errored) NotBlank(value string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeBlank(value); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Equal asserts that two objects are equal.
+func (e *Errored) Equal(expected interface{}, actual interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeEqual(expected, actual); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// EqualValues asserts that two objects are equal after converting either one to the other's type.
+// Numbers of different types compare by value, e.g. `EqualValues(int32(5), int64(5))` passes
+// and `EqualValues(int64(300), int8(44))` fails.
+func (e *Errored) EqualValues(expected interface{}, actual interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeEqualValues(expected, actual); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// ExactEqual asserts that two objects have identical types and are deeply equal.
+// Unlike `Equal`, the expected value is not converted to the actual value's type,
+// e.g. `ExactEqual(int64(1), int32(1))` fails because the types differ.
+func (e *Errored) ExactEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeExactEqual(expected, actual); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// ReferenceEqual asserts that two objects are the same underlying reference in memory.
+func (e *Errored) ReferenceEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeReferenceEqual(expected, actual); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotEqual asserts that two objects are not equal.
+func (e *Errored) NotEqual(expected interface{}, actual interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeEqual(expected, actual); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// PanicEqual asserts the panic emitted by an actin equals an expected value.
+func (e *Errored) PanicEqual(expected interface{}, action func(), userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBePanicEqual(expected, action); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// PanicStackContains asserts that an action panics, and that the stack at the panic contains a substring,
+// e.g. the name of the function the panic should originate from.
+func (e *Errored) PanicStackContains(substring string, action func(), userMessageComponents ...interface{}) error {
+	if didFail, message := shouldPanicStackContain(substring, action); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Zero asserts that a value is the default value.
+func (e *Errored) Zero(value interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeZero(value); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotZero asserts that a value is not the default value.
+func (e *Errored) NotZero(value interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeNonZero(value); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// RequiredFieldsSet asserts that the named fields of a struct are not their zero values.
+// Fields can be nested with dotted paths, e.g. `Web.Port`; all unset fields are listed on failure.
+func (e *Errored) RequiredFieldsSet(obj interface{}, fields []string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveRequiredFieldsSet(obj, fields); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// FieldEqual asserts that the field at a path in an object equals an expected value.
+// The path is dotted, with indices for slices and arrays, e.g. `user.addresses[0].zip`;
+// struct fields are matched by name or json tag, and maps must have string keys.
+func (e *Errored) FieldEqual(object interface{}, path string, expected interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveFieldEqual(object, path, expected); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// FieldNotZero asserts that the field at a path in an object is not its zero value, see `FieldEqual` for the path syntax.
+func (e *Errored) FieldNotZero(object interface{}, path string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveFieldNotZero(object, path); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// True asserts that a bool is true.
+func (e *Errored) True(object bool, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeTrue(object); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// False asserts that a bool is false.
+func (e *Errored) False(object bool, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeFalse(object); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// InDelta asserts that two float64s are separated by a given delta.
+func (e *Errored) InDelta(a, b, delta float64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeInDelta(a, b, delta); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotInDelta asserts that two floats are not within a delta, i.e. that their absolute difference is greater than delta.
+// NaN is never considered to be outside a delta, and fails the assertion.
+func (e *Errored) NotInDelta(f0, f1, delta float64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeInDelta(f0, f1, delta); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// MapInDelta asserts that two float maps have the same keys, and that the values for each key are within a delta.
+// All keys with values outside the delta, and any missing or unexpected keys, are reported.
+func (e *Errored) MapInDelta(expected, actual map[string]float64, delta float64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeMapInDelta(expected, actual, delta); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// InEpsilon asserts that two floats are within a relative tolerance, i.e. that `|expected-actual| / |expected|` is at most epsilon.
+// If expected is zero, the absolute difference is compared to epsilon instead.
+func (e *Errored) InEpsilon(expected, actual, epsilon float64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeInEpsilon(expected, actual, epsilon); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// WithinPercent asserts that a number is within a percentage of an expected number, e.g. `WithinPercent(98, 100, 5)`.
+// If the expected number is zero, the actual number must also be zero.
+func (e *Errored) WithinPercent(actual, expected, percent float64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeWithinPercent(actual, expected, percent); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Positive asserts that a number is greater than zero.
+func (e *Errored) Positive(value interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBePositive(value); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Negative asserts that a number is less than zero.
+func (e *Errored) Negative(value interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeNegative(value); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// TimeBefore asserts that a time is before another time.
+func (e *Errored) TimeBefore(t1, t2 time.Time, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeTimeBefore(t1, t2); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// TimeAfter asserts that a time is after another time.
+func (e *Errored) TimeAfter(t1, t2 time.Time, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeTimeAfter(t1, t2); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// TimeEqual asserts that two times are the same instant, as with `time.Time.Equal`.
+// Unlike `Equal`, the location and monotonic clock reading of the times are not compared.
+func (e *Errored) TimeEqual(t1, t2 time.Time, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeTimeEqual(t1, t2); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// SameDay asserts that two times are on the same calendar day, ignoring the time of day.
+// If both times have the same location, they are compared in that location, otherwise in UTC.
+func (e *Errored) SameDay(t0, t1 time.Time, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeSameDay(t0, t1, nil); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// SameDayIn asserts that two times are on the same calendar day in a given location, ignoring the time of day.
+// If the location is nil, the times are compared as with `SameDay`.
+func (e *Errored) SameDayIn(t0, t1 time.Time, location *time.Location, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeSameDay(t0, t1, location); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// IsNaN asserts that a float is NaN.
+func (e *Errored) IsNaN(f float64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeNaN(f); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotNaN asserts that a float is not NaN.
+func (e *Errored) NotNaN(f float64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeNaN(f); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// IsInf asserts that a float is an infinity, according to sign.
+// If sign > 0, the float must be positive infinity, if sign < 0, negative infinity, and if sign == 0, either infinity.
+func (e *Errored) IsInf(f float64, sign int, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeInf(f, sign); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotInf asserts that a float is not an infinity, according to sign.
+// If sign > 0, the float must not be positive infinity, if sign < 0, negative infinity, and if sign == 0, either infinity.
+func (e *Errored) NotInf(f float64, sign int, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotBeInf(f, sign); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// InTimeDelta asserts that two times are separated by a given delta.
+func (e *Errored) InTimeDelta(a, b time.Time, delta time.Duration, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeInTimeDelta(a, b, delta); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// InTimeDeltaNow asserts that a time is within a delta of the current time, e.g. that a timestamp is recent.
+func (e *Errored) InTimeDeltaNow(t time.Time, delta time.Duration, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeInTimeDelta(t, time.Now(), delta); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// FileExists asserts that a file exists on disk at a given filepath.
+func (e *Errored) FileExists(filepath string, userMessageComponents ...interface{}) error {
+	if didFail, message := fileShouldExist(filepath); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// FileNotExists asserts that a file does not exist on disk at a given filepath.
+func (e *Errored) FileNotExists(filepath string, userMessageComponents ...interface{}) error {
+	if didFail, message := fileShouldNotExist(filepath); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// DirExists asserts that a directory exists on disk at a given filepath.
+func (e *Errored) DirExists(filepath string, userMessageComponents ...interface{}) error {
+	if didFail, message := dirShouldExist(filepath); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// FileContains checks if a file on disk at a given filepath contains a substring.
+func (e *Errored) FileContains(filepath, substring string, userMessageComponents ...interface{}) error {
+	if didFail, message := fileShouldContain(filepath, substring); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// FileContents checks if a file on disk at a given filepath has the expected contents.
+// The failure message shows the first difference.
+func (e *Errored) FileContents(filepath string, expected []byte, userMessageComponents ...interface{}) error {
+	if didFail, message := fileShouldHaveContents(filepath, expected); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// FileSizeGreaterThan checks if a file on disk at a given filepath is larger than a given number of bytes.
+func (e *Errored) FileSizeGreaterThan(filepath string, size int64, userMessageComponents ...interface{}) error {
+	if didFail, message := fileShouldHaveSizeGreaterThan(filepath, size); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Contains checks if a substring is present in a corpus.
+func (e *Errored) Contains(corpus, substring string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldContain(corpus, substring); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotContains checks if a substring is not present in a corpus.
+func (e *Errored) NotContains(corpus, substring string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotContain(corpus, substring); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// ContainsFold asserts that a substring is present in a corpus, under Unicode case folding.
+func (e *Errored) ContainsFold(corpus, substring string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldContainFold(corpus, substring); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NotContainsFold asserts that a substring is not present in a corpus, under Unicode case folding.
+func (e *Errored) NotContainsFold(corpus, substring string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNotContainFold(corpus, substring); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// HasPrefix asserts that a corpus starts with a prefix.
+func (e *Errored) HasPrefix(corpus, prefix string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHavePrefix(corpus, prefix); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// HasSuffix asserts that a corpus ends with a suffix.
+func (e *Errored) HasSuffix(corpus, suffix string, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldHaveSuffix(corpus, suffix); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AnyIndexed applies a predicate that also receives each element's index.
+func (e *Errored) AnyIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAnyIndexed(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// Any applies a predicate.
+func (e *Errored) Any(target interface{}, predicate Predicate, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAny(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AnyOfInt applies a predicate.
+func (e *Errored) AnyOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAnyOfInt(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AnyOfInt64 applies a predicate.
+func (e *Errored) AnyOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAnyOfInt64(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AnyOfUint applies a predicate.
+func (e *Errored) AnyOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAnyOfUint(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AnyOfFloat applies a predicate.
+func (e *Errored) AnyOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAnyOfFloat(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AnyOfString applies a predicate.
+func (e *Errored) AnyOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAnyOfString(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// UniqueBy asserts that the elements of a slice or array are distinct by a key,
+// i.e. that no two elements share the key returned by `key`.
+func (e *Errored) UniqueBy(target interface{}, key func(interface{}) interface{}, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldBeUniqueBy(target, key); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AllIndexed applies a predicate that also receives each element's index.
+func (e *Errored) AllIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAllIndexed(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// All applies a predicate.
+func (e *Errored) All(target interface{}, predicate Predicate, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAll(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AllOfInt applies a predicate.
+func (e *Errored) AllOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAllOfInt(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AllOfInt64 applies a predicate.
+func (e *Errored) AllOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAllOfInt64(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AllOfUint applies a predicate.
+func (e *Errored) AllOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAllOfUint(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AllOfFloat applies a predicate.
+func (e *Errored) AllOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAllOfFloat(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// AllOfString applies a predicate.
+func (e *Errored) AllOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldAllOfString(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NoneIndexed applies a predicate that also receives each element's index.
+func (e *Errored) NoneIndexed(target interface{}, predicate PredicateIndexed, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNoneIndexed(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// None applies a predicate.
+func (e *Errored) None(target interface{}, predicate Predicate, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNone(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NoneOfInt applies a predicate.
+func (e *Errored) NoneOfInt(target []int, predicate PredicateOfInt, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNoneOfInt(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NoneOfInt64 applies a predicate.
+func (e *Errored) NoneOfInt64(target []int64, predicate PredicateOfInt64, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNoneOfInt64(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NoneOfUint applies a predicate.
+func (e *Errored) NoneOfUint(target []uint, predicate PredicateOfUint, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNoneOfUint(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NoneOfFloat applies a predicate.
+func (e *Errored) NoneOfFloat(target []float64, predicate PredicateOfFloat, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNoneOfFloat(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
+
+// NoneOfString applies a predicate.
+func (e *Errored) NoneOfString(target []string, predicate PredicateOfString, userMessageComponents ...interface{}) error {
+	if didFail, message := shouldNoneOfString(target, predicate); didFail {
+		return e.error(message, userMessageComponents...)
+	}
+	return nil
+}
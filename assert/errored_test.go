@@ -0,0 +1,48 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestErrored(t *testing.T) {
+	check := NewErrored()
+
+	if err := check.NotNil("foo"); err != nil {
+		t.Errorf("should not have returned an error, actual: %v", err)
+		t.FailNow()
+	}
+	if err := check.Equal(1, 1); err != nil {
+		t.Errorf("should not have returned an error, actual: %v", err)
+		t.FailNow()
+	}
+
+	err := check.NotNil(nil, "config is required")
+	if err == nil {
+		t.Errorf("should have returned an error")
+		t.FailNow()
+	}
+	if err.Error() != "Should not be nil: config is required" {
+		t.Errorf("should have returned the failure and user message, actual: %s", err.Error())
+		t.FailNow()
+	}
+
+	err = check.Equal(1, 2)
+	if err == nil {
+		t.Errorf("should have returned an error")
+		t.FailNow()
+	}
+	if strings.Contains(err.Error(), "\033[") {
+		t.Errorf("should not have returned ansi color codes, actual: %q", err.Error())
+		t.FailNow()
+	}
+	if !strings.Contains(err.Error(), "Expected: \t1") || !strings.Contains(err.Error(), "Actual: \t2") {
+		t.Errorf("should have returned the failure message, actual: %q", err.Error())
+		t.FailNow()
+	}
+
+	if err := check.AllOfInt([]int{1, 2, 3}, func(v int) bool { return v > 1 }); err == nil {
+		t.Errorf("should have returned an error")
+		t.FailNow()
+	}
+}
@@ -2,6 +2,9 @@ package assert
 
 import (
 	"flag"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 )
 
@@ -11,9 +14,9 @@ var (
 	integration = flag.Bool("integration", false, "If we should run integration tests")
 )
 
-func init() {
-	flag.Parse()
-}
+// EnvVarFilters is the environment variable that selects which filters run.
+// It is a comma delimited list of filters, e.g. `ASSERT_FILTERS=unit,integration`.
+const EnvVarFilters = "ASSERT_FILTERS"
 
 // Filter is a unit test filter.
 type Filter string
@@ -28,24 +31,57 @@ const (
 	Integration = "integration"
 )
 
-// CheckFilter checks the filter.
-func CheckFilter(t *testing.T, filter Filter) {
-	if !*unit && !*acceptance && !*integration {
-		return
+// EnabledFilters returns the filters selected to run, either by
+// the `-unit`, `-acceptance` and `-integration` flags or by the `ASSERT_FILTERS` environment variable.
+// If it returns an empty set, all tests run.
+func EnabledFilters() []Filter {
+	var enabled []Filter
+	if unit != nil && *unit {
+		enabled = append(enabled, Unit)
 	}
-
-	switch filter {
-	case Unit:
-		if unit != nil && !*unit {
-			t.Skip()
-		}
-	case Acceptance:
-		if acceptance != nil && !*acceptance {
-			t.Skip()
+	if acceptance != nil && *acceptance {
+		enabled = append(enabled, Acceptance)
+	}
+	if integration != nil && *integration {
+		enabled = append(enabled, Integration)
+	}
+	for _, value := range strings.Split(os.Getenv(EnvVarFilters), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			enabled = append(enabled, Filter(strings.ToLower(value)))
 		}
-	case Integration:
-		if integration != nil && !*integration {
-			t.Skip()
+	}
+	return enabled
+}
+
+// CheckFilter skips the test if it does not belong to any of the enabled filters.
+// A test belongs to a filter if it is listed in `filters`, i.e. `CheckFilter(t, Unit, Integration)`
+// runs if either unit or integration tests are enabled.
+// If no filters are enabled, every test runs.
+func CheckFilter(t *testing.T, filters ...Filter) {
+	if reason, shouldSkip := filterSkipReason(EnabledFilters(), filters...); shouldSkip {
+		t.Skip(reason)
+	}
+}
+
+// filterSkipReason returns if a test with a given set of filters should be skipped, and why.
+func filterSkipReason(enabled []Filter, filters ...Filter) (string, bool) {
+	if len(enabled) == 0 || len(filters) == 0 {
+		return EMPTY, false
+	}
+	for _, filter := range filters {
+		for _, enabledFilter := range enabled {
+			if filter == enabledFilter {
+				return EMPTY, false
+			}
 		}
 	}
+	return fmt.Sprintf("skipping; test filters %s are not enabled (enabled: %s)", joinFilters(filters), joinFilters(enabled)), true
+}
+
+func joinFilters(filters []Filter) string {
+	values := make([]string, len(filters))
+	for index, filter := range filters {
+		values[index] = string(filter)
+	}
+	return strings.Join(values, ",")
 }
@@ -0,0 +1,85 @@
+package assert
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func withFiltersEnv(t *testing.T, value string, action func()) {
+	previous, hadPrevious := os.LookupEnv(EnvVarFilters)
+	os.Setenv(EnvVarFilters, value)
+	defer func() {
+		if hadPrevious {
+			os.Setenv(EnvVarFilters, previous)
+		} else {
+			os.Unsetenv(EnvVarFilters)
+		}
+	}()
+	action()
+}
+
+func TestFilterSkipReason(t *testing.T) {
+	if _, shouldSkip := filterSkipReason(nil, Integration); shouldSkip {
+		t.Errorf("should run everything when no filters are enabled")
+	}
+	if _, shouldSkip := filterSkipReason([]Filter{Unit}); shouldSkip {
+		t.Errorf("should run tests without filters")
+	}
+	if _, shouldSkip := filterSkipReason([]Filter{Unit}, Unit, Integration); shouldSkip {
+		t.Errorf("should run tests that belong to any enabled filter")
+	}
+
+	reason, shouldSkip := filterSkipReason([]Filter{Unit}, Acceptance, Integration)
+	if !shouldSkip {
+		t.Errorf("should skip tests that do not belong to an enabled filter")
+	}
+	if !strings.Contains(reason, "acceptance,integration") || !strings.Contains(reason, "enabled: unit") {
+		t.Errorf("skip reason should name the filters, actual: %s", reason)
+	}
+}
+
+func TestEnabledFiltersEnv(t *testing.T) {
+	withFiltersEnv(t, "", func() {
+		if len(EnabledFilters()) != 0 {
+			t.Errorf("should have no enabled filters")
+		}
+	})
+	withFiltersEnv(t, "unit, Integration", func() {
+		enabled := EnabledFilters()
+		if len(enabled) != 2 || enabled[0] != Unit || enabled[1] != Integration {
+			t.Errorf("should have parsed the enabled filters, actual: %v", enabled)
+		}
+	})
+}
+
+func TestCheckFilter(t *testing.T) {
+	var included, excluded, unset *testing.T
+
+	withFiltersEnv(t, "unit", func() {
+		t.Run("included", func(st *testing.T) {
+			included = st
+			Filtered(st, Unit, Integration)
+		})
+		t.Run("excluded", func(st *testing.T) {
+			excluded = st
+			New(st).WithFilters(Integration)
+		})
+	})
+	withFiltersEnv(t, "", func() {
+		t.Run("unset", func(st *testing.T) {
+			unset = st
+			CheckFilter(st, Integration)
+		})
+	})
+
+	if included.Skipped() {
+		t.Errorf("included test should not have been skipped")
+	}
+	if !excluded.Skipped() {
+		t.Errorf("excluded test should have been skipped")
+	}
+	if unset.Skipped() {
+		t.Errorf("test should not have been skipped without enabled filters")
+	}
+}
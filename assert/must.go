@@ -0,0 +1,69 @@
+package assert
+
+import (
+	"fmt"
+	"path"
+	"runtime"
+)
+
+// MustError is the panic value of a failed `Must` helper, e.g. `MustNotNil`.
+type MustError struct {
+	// Location is the file and line that called the `Must` helper.
+	Location string
+	// Message is the assertion failure message, without ansi color codes.
+	Message string
+	// UserMessage is the message given by the caller, if any.
+	UserMessage string
+}
+
+// Error implements error.
+func (me *MustError) Error() string {
+	if len(me.UserMessage) > 0 {
+		return fmt.Sprintf("%s: %s: %s", me.Location, me.Message, me.UserMessage)
+	}
+	return fmt.Sprintf("%s: %s", me.Location, me.Message)
+}
+
+// MustNotNil panics with a `*MustError` if the object is nil.
+// It is useful for checking preconditions outside of tests, e.g.
+//
+//	assert.MustNotNil(cfg, "config is required")
+func MustNotNil(object interface{}, userMessageComponents ...interface{}) {
+	if didFail, message := shouldNotBeNil(object); didFail {
+		mustFail(message, userMessageComponents...)
+	}
+}
+
+// MustNotEmpty panics with a `*MustError` if the collection is empty.
+func MustNotEmpty(collection interface{}, userMessageComponents ...interface{}) {
+	if didFail, message := shouldNotBeEmpty(collection); didFail {
+		mustFail(message, userMessageComponents...)
+	}
+}
+
+// MustTrue panics with a `*MustError` if the value is false.
+func MustTrue(value bool, userMessageComponents ...interface{}) {
+	if didFail, message := shouldBeTrue(value); didFail {
+		mustFail(message, userMessageComponents...)
+	}
+}
+
+// MustEqual panics with a `*MustError` if the objects are not equal.
+func MustEqual(expected, actual interface{}, userMessageComponents ...interface{}) {
+	if didFail, message := shouldBeEqual(expected, actual); didFail {
+		mustFail(message, userMessageComponents...)
+	}
+}
+
+// mustFail panics with a `*MustError` located at the caller of the `Must` helper.
+func mustFail(message string, userMessageComponents ...interface{}) {
+	location := "Unknown"
+	if _, file, line, ok := runtime.Caller(2); ok {
+		location = fmt.Sprintf("%s:%d", path.Base(file), line)
+	}
+	panic(&MustError{
+		Location:    location,
+		Message:     stripColor(message),
+		UserMessage: fmt.Sprint(userMessageComponents...),
+	})
+}
@@ -0,0 +1,65 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+// recoverMust runs an action and returns the `*MustError` it panics with, if any.
+func recoverMust(action func()) (err *MustError) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(*MustError)
+		}
+	}()
+	action()
+	return
+}
+
+func TestMust(t *testing.T) {
+	for _, testCase := range []struct {
+		Name    string
+		Action  func()
+		Message string
+	}{
+		{Name: "MustNotNil pass", Action: func() { MustNotNil("foo") }},
+		{Name: "MustNotNil fail", Action: func() { MustNotNil(nil) }, Message: "Should not be nil"},
+		{Name: "MustNotEmpty pass", Action: func() { MustNotEmpty([]int{1}) }},
+		{Name: "MustNotEmpty fail", Action: func() { MustNotEmpty([]int{}) }, Message: "Should not be empty"},
+		{Name: "MustTrue pass", Action: func() { MustTrue(true) }},
+		{Name: "MustTrue fail", Action: func() { MustTrue(false) }, Message: "Should be true"},
+		{Name: "MustEqual pass", Action: func() { MustEqual(1, 1) }},
+		{Name: "MustEqual fail", Action: func() { MustEqual(1, 2) }, Message: "Expected"},
+	} {
+		err := recoverMust(testCase.Action)
+		if testCase.Message == "" {
+			if err != nil {
+				t.Errorf("%s: should not have panicked, got: %v", testCase.Name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: should have panicked", testCase.Name)
+			continue
+		}
+		if !strings.Contains(err.Message, testCase.Message) {
+			t.Errorf("%s: message should contain %q, got: %q", testCase.Name, testCase.Message, err.Message)
+		}
+	}
+}
+
+func TestMustError(t *testing.T) {
+	err := recoverMust(func() { MustNotNil(nil, "config is required") })
+	if err == nil {
+		t.Fatal("should have panicked")
+	}
+	if !strings.HasPrefix(err.Location, "must_test.go:") {
+		t.Errorf("location should be the caller, got: %q", err.Location)
+	}
+	if err.UserMessage != "config is required" {
+		t.Errorf("unexpected user message: %q", err.UserMessage)
+	}
+	if expected := err.Location + ": Should not be nil: config is required"; err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
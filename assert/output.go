@@ -0,0 +1,63 @@
+package assert
+
+import (
+	"io"
+	"sync"
+)
+
+// outputLock serializes writing failures to outputs, since assertions that share
+// outputs can fail concurrently, e.g. in parallel subtests.
+var outputLock sync.Mutex
+
+// outputWriters are the writers failures are written to.
+type outputWriters []io.Writer
+
+// with returns the writers with additional writers, skipping nil writers.
+// It never modifies the existing writers, which can be shared between assertions.
+func (ow outputWriters) with(writers ...io.Writer) outputWriters {
+	combined := ow[:len(ow):len(ow)]
+	for _, w := range writers {
+		if w != nil {
+			combined = append(combined, w)
+		}
+	}
+	return combined
+}
+
+// writer returns the writers as a single writer, or nil if there are none.
+func (ow outputWriters) writer() io.Writer {
+	if len(ow) == 0 {
+		return nil
+	}
+	return ow
+}
+
+// output returns the writers as a single writer for callers, or nil if there are none.
+func (ow outputWriters) output() io.Writer {
+	switch len(ow) {
+	case 0:
+		return nil
+	case 1:
+		return ow[0]
+	default:
+		return io.MultiWriter(ow...)
+	}
+}
+
+// Write writes to each writer. A failing writer does not prevent writing to the other writers;
+// the first error is returned after writing to all of them.
+func (ow outputWriters) Write(contents []byte) (int, error) {
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	var firstErr error
+	for _, w := range ow {
+		if _, err := w.Write(contents); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return 0, firstErr
+	}
+	return len(contents), nil
+}
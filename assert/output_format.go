@@ -0,0 +1,49 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// OutputFormat is a format failures are written to an output writer in.
+type OutputFormat string
+
+// Output formats.
+const (
+	// FormatText writes failures as human readable text; it is the default.
+	FormatText OutputFormat = "text"
+	// FormatJSON writes each failure as a single json line, without ansi color codes.
+	FormatJSON OutputFormat = "json"
+)
+
+// FailFormatter formats a failure, given its location, the assertion's message without ansi color codes,
+// the user message, and if the failure is fatal, i.e. not from `NonFatal` assertions.
+// The location is the list of callers that led to the assertion, separated by commas.
+type FailFormatter func(location, assertion, userMessage string, fatal bool) string
+
+// JSONFailure is a failure as it is written with `FormatJSON`.
+type JSONFailure struct {
+	Message     string    `json:"message"`
+	UserMessage string    `json:"userMessage,omitempty"`
+	Callers     []string  `json:"callers"`
+	Timestamp   time.Time `json:"timestamp"`
+	Fatal       bool      `json:"fatal"`
+}
+
+// writeJSONFailure writes a failure to an output writer as a json line.
+func writeJSONFailure(w io.Writer, fatal bool, callers []string, message string, userMessageComponents ...interface{}) {
+	if callers == nil {
+		callers = []string{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(JSONFailure{
+		Message:     stripColor(message),
+		UserMessage: fmt.Sprint(userMessageComponents...),
+		Callers:     callers,
+		Timestamp:   time.Now().UTC(),
+		Fatal:       fatal,
+	})
+}
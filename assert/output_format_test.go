@@ -0,0 +1,143 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var jsonFailureTimestamp = regexp.MustCompile(`"timestamp":"[^"]*"`)
+
+// assertJSONFailureGolden compares json failure output, with the timestamp removed, to a golden file in testdata.
+func assertJSONFailureGolden(t *testing.T, output *bytes.Buffer, golden string) {
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", golden))
+	if err != nil {
+		t.Errorf("should have read the golden file: %v", err)
+		t.FailNow()
+	}
+	actual := jsonFailureTimestamp.ReplaceAllString(output.String(), `"timestamp":"<timestamp>"`)
+	if actual != string(expected) {
+		t.Errorf("json failure should match %s\nexpected: %s\nactual:   %s", golden, expected, actual)
+		t.FailNow()
+	}
+}
+
+func TestAssertJSONOutputFormatEqual(t *testing.T) {
+	output := bytes.NewBuffer(nil)
+	err := safeExec(func() {
+		New(nil).WithOutput(output).WithColor(true).WithOutputFormat(FormatJSON).Equal("foo", "bar")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if strings.Contains(output.String(), "\033[") {
+		t.Errorf("should not have written ansi color codes, actual: %q", output.String())
+		t.FailNow()
+	}
+	if strings.Count(output.String(), "\n") != 1 {
+		t.Errorf("should have written a single line, actual: %q", output.String())
+		t.FailNow()
+	}
+	assertJSONFailureGolden(t, output, "json_failure_equal.golden")
+}
+
+func TestAssertJSONOutputFormatNil(t *testing.T) {
+	output := bytes.NewBuffer(nil)
+	err := safeExec(func() {
+		New(nil).WithOutput(output).WithOutputFormat(FormatJSON).Nil("foo", "should be unset")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	assertJSONFailureGolden(t, output, "json_failure_nil.golden")
+}
+
+func TestAssertJSONOutputFormatNonFatal(t *testing.T) {
+	output := bytes.NewBuffer(nil)
+	o := New(nil).WithOutput(output).WithOutputFormat(FormatJSON).NonFatal()
+	if o.Len([]int{1, 2}, 3) {
+		t.Errorf("should have failed")
+		t.FailNow()
+	}
+	assertJSONFailureGolden(t, output, "json_failure_len_non_fatal.golden")
+
+	var failure JSONFailure
+	if err := json.Unmarshal(output.Bytes(), &failure); err != nil {
+		t.Errorf("should have written valid json: %v", err)
+		t.FailNow()
+	}
+	if failure.Fatal || failure.Timestamp.IsZero() || !strings.Contains(failure.Message, "\n") {
+		t.Errorf("should have written the failure fields, actual: %#v", failure)
+		t.FailNow()
+	}
+}
+
+func TestAssertWithFailFormatter(t *testing.T) {
+	type formatted struct {
+		Location    string
+		Assertion   string
+		UserMessage string
+		Fatal       bool
+	}
+	var calls []formatted
+	formatter := func(location, assertion, userMessage string, fatal bool) string {
+		calls = append(calls, formatted{location, assertion, userMessage, fatal})
+		contents, _ := json.Marshal(map[string]interface{}{"assertion": assertion, "fatal": fatal})
+		return string(contents) + "\n"
+	}
+
+	output := bytes.NewBuffer(nil)
+	a := New(nil).WithOutput(output).WithColor(true).WithFailFormatter(formatter)
+	if a.FailFormatter() == nil {
+		t.Errorf("should have set the fail formatter")
+		t.FailNow()
+	}
+
+	err := safeExec(func() {
+		a.Equal(1, 2, "user", " message")
+	})
+	if err == nil {
+		t.Errorf("should have produced a panic")
+		t.FailNow()
+	}
+	if !a.NonFatal().True(true) || a.NonFatal().True(false) {
+		t.Errorf("the non fatal assertions should have inherited the fail formatter")
+		t.FailNow()
+	}
+
+	if len(calls) != 2 {
+		t.Errorf("should have called the formatter for each failure, actual: %d", len(calls))
+		t.FailNow()
+	}
+	if !calls[0].Fatal || calls[1].Fatal {
+		t.Errorf("should have passed if the failures were fatal, actual: %v", calls)
+		t.FailNow()
+	}
+	if calls[0].UserMessage != "user message" || calls[0].Location == "" {
+		t.Errorf("should have passed the user message and location, actual: %v", calls[0])
+		t.FailNow()
+	}
+	if strings.Contains(calls[0].Assertion, "\033[") || !strings.Contains(calls[0].Assertion, "Expected") {
+		t.Errorf("should have passed the assertion message without colors, actual: %q", calls[0].Assertion)
+		t.FailNow()
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 2 || strings.Contains(output.String(), "Assertion Failed!") {
+		t.Errorf("should have only written the formatted failures, actual: %s", output.String())
+		t.FailNow()
+	}
+	for _, line := range lines {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+			t.Errorf("should have written json lines, actual: %s", line)
+			t.FailNow()
+		}
+	}
+}
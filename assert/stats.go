@@ -1,7 +1,9 @@
 package assert
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"sync/atomic"
 	"testing"
@@ -11,6 +13,12 @@ import (
 // assertcount is the total number of assetions run during the package lifetime.
 var assertCount int32
 
+// failedCount is the total number of failed assertions (fatal or not) run during the package lifetime.
+var failedCount int32
+
+// fatalCount is the total number of fatal assertion failures during the package lifetime.
+var fatalCount int32
+
 // Increment increments the global assertion count.
 func Increment() {
 	atomic.AddInt32(&assertCount, int32(1))
@@ -21,6 +29,50 @@ func Count() int {
 	return int(atomic.LoadInt32(&assertCount))
 }
 
+// ResetCount resets the total number of assertions to zero.
+func ResetCount() {
+	atomic.StoreInt32(&assertCount, 0)
+}
+
+// incrementFailed increments the global failed assertion count.
+func incrementFailed() {
+	atomic.AddInt32(&failedCount, int32(1))
+}
+
+// incrementFatal increments the global fatal assertion failure count.
+func incrementFatal() {
+	atomic.AddInt32(&fatalCount, int32(1))
+}
+
+// Statistics are the aggregate assertion counts for the package lifetime.
+type Statistics struct {
+	Total            int `json:"total"`
+	Failed           int `json:"failed"`
+	NonFatalFailures int `json:"nonFatalFailures"`
+}
+
+// Stats returns the current assertion statistics.
+func Stats() Statistics {
+	failed := int(atomic.LoadInt32(&failedCount))
+	return Statistics{
+		Total:            Count(),
+		Failed:           failed,
+		NonFatalFailures: failed - int(atomic.LoadInt32(&fatalCount)),
+	}
+}
+
+// ResetStats resets the assertion statistics; it is useful in a `TestMain`.
+func ResetStats() {
+	ResetCount()
+	atomic.StoreInt32(&failedCount, 0)
+	atomic.StoreInt32(&fatalCount, 0)
+}
+
+// StatsHandler writes the current assertion statistics as json to a given writer.
+func StatsHandler(w io.Writer) error {
+	return json.NewEncoder(w).Encode(Stats())
+}
+
 // started is when the package started.
 var started time.Time
 
@@ -0,0 +1,113 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	ResetStats()
+
+	New(nil).True(true)
+	safeExec(func() {
+		New(nil).WithOutput(bytes.NewBuffer(nil)).True(false)
+	})
+	New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().True(false)
+	New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().True(false)
+
+	stats := Stats()
+	if stats.Total != 4 {
+		t.Errorf("should have counted 4 assertions, got %d", stats.Total)
+		t.FailNow()
+	}
+	if stats.Failed != 3 {
+		t.Errorf("should have counted 3 failed assertions, got %d", stats.Failed)
+		t.FailNow()
+	}
+	if stats.NonFatalFailures != 2 {
+		t.Errorf("should have counted 2 non-fatal failures, got %d", stats.NonFatalFailures)
+		t.FailNow()
+	}
+
+	ResetStats()
+	if stats = Stats(); stats.Total != 0 || stats.Failed != 0 || stats.NonFatalFailures != 0 {
+		t.Errorf("should have reset the stats")
+		t.FailNow()
+	}
+}
+
+func TestStatsConcurrent(t *testing.T) {
+	ResetStats()
+
+	wg := sync.WaitGroup{}
+	wg.Add(8)
+	for x := 0; x < 8; x++ {
+		go func() {
+			defer wg.Done()
+			for y := 0; y < 100; y++ {
+				New(nil).True(true)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats := Stats(); stats.Total != 800 {
+		t.Errorf("should have counted 800 assertions, got %d", stats.Total)
+		t.FailNow()
+	}
+}
+
+func TestStatsHandler(t *testing.T) {
+	ResetStats()
+	New(nil).True(true)
+	New(nil).WithOutput(bytes.NewBuffer(nil)).NonFatal().True(false)
+
+	buf := bytes.NewBuffer(nil)
+	if err := StatsHandler(buf); err != nil {
+		t.Errorf("should not have errored: %v", err)
+		t.FailNow()
+	}
+
+	var stats Statistics
+	if err := json.Unmarshal(buf.Bytes(), &stats); err != nil {
+		t.Errorf("should have written valid json: %v", err)
+		t.FailNow()
+	}
+	if stats.Total != 2 || stats.Failed != 1 || stats.NonFatalFailures != 1 {
+		t.Errorf("should have written the current stats, got %#v", stats)
+		t.FailNow()
+	}
+}
+
+func TestCountParallel(t *testing.T) {
+	ResetCount()
+	if Count() != 0 {
+		t.Errorf("should have reset the count")
+		t.FailNow()
+	}
+
+	t.Run("group", func(t *testing.T) {
+		for x := 0; x < 8; x++ {
+			t.Run("parallel", func(t *testing.T) {
+				t.Parallel()
+				for y := 0; y < 100; y++ {
+					New(t).True(true)
+					New(t).NonFatal().True(true)
+				}
+			})
+		}
+	})
+
+	if count := Count(); count != 1600 {
+		t.Errorf("should have counted 1600 assertions, got %d", count)
+		t.FailNow()
+	}
+
+	ResetCount()
+	if Count() != 0 {
+		t.Errorf("should have reset the count")
+		t.FailNow()
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/blend/go-sdk/jobkit"
 	"github.com/blend/go-sdk/logger"
 	"github.com/blend/go-sdk/sh"
+	"github.com/blend/go-sdk/slack"
 	"github.com/blend/go-sdk/stringutil"
 )
 
@@ -46,8 +47,13 @@ func main() {
 		logger.FatalExit(err)
 	}
 
-	log := logger.NewFromConfig(&config.Logger)
+	log, err := logger.NewFromConfig(&config.Logger)
+	if err != nil {
+		logger.FatalExit(err)
+	}
 	log.WithEnabled(cron.FlagStarted, cron.FlagComplete, cron.FlagFixed, cron.FlagBroken, cron.FlagFailed, cron.FlagCancelled)
+	// post logger errors to slack, if it is configured.
+	slack.AddListeners(log, &config.Slack)
 
 	log.SyncInfof("starting job `%s`", config.NameOrDefault())
 	log.SyncInfof("using schedule `%s`", config.ScheduleOrDefault())
@@ -58,12 +64,12 @@ func main() {
 	} else {
 		command, err = sh.ParseFlagsTrailer(os.Args...)
 		if err != nil {
-			logger.FatalExit(err)
+			log.SyncFatalExit(err)
 		}
 	}
 
 	if len(command) == 0 {
-		logger.FatalExit(fmt.Errorf("must supply a command to run with `--exec=...` or `-- command`)"))
+		log.SyncFatalExit(fmt.Errorf("must supply a command to run with `--exec=...` or `-- command`)"))
 	}
 
 	action := func(ctx context.Context) error {
@@ -72,24 +78,40 @@ func main() {
 
 	job, err := jobkit.New(&config.JobConfig, &config.Config, action)
 	if err != nil {
-		logger.FatalExit(err)
+		log.SyncFatalExit(err)
 	}
 	job.WithLogger(log)
 
 	jobs := cron.NewFromConfig(&config.Config.Config).WithLogger(log)
-	jobs.LoadJob(job)
+	if err := jobs.LoadJob(job); err != nil {
+		log.SyncFatalExit(err)
+	}
+
+	stopReload := jobkit.ReloadOnSignal(jobs, log, func() ([]cron.Job, error) {
+		var reloaded jobConfig
+		if err := configutil.Read(&reloaded, *configPath); !configutil.IsIgnored(err) {
+			return nil, err
+		}
+		job, err := jobkit.New(&reloaded.JobConfig, &reloaded.Config, action)
+		if err != nil {
+			return nil, err
+		}
+		job.WithLogger(log)
+		return []cron.Job{job}, nil
+	})
+	defer stopReload()
 
 	if !config.DisableManagementServer {
 		ws := jobkit.NewManagementServer(jobs, &config.Config).WithLogger(log)
 		go func() {
 			if err := graceful.Shutdown(ws); err != nil {
-				logger.FatalExit(err)
+				log.SyncFatalExit(err)
 			}
 		}()
 	}
 
 	if err := graceful.Shutdown(graceful.New(jobs.Start, jobs.Stop)); err != nil {
-		logger.FatalExit(err)
+		log.SyncFatalExit(err)
 	}
 }
 
@@ -4,3 +4,13 @@ import "context"
 
 // Action is an function that can be run as a task
 type Action func(ctx context.Context) error
+
+// ContextFactory enriches the context of a job run, returning an optional cleanup func
+// that is called after the run finishes.
+type ContextFactory func(ctx context.Context) (context.Context, func(), error)
+
+// BeforeRunHook is called before each job run; returning an error aborts the run, which is recorded as failed.
+type BeforeRunHook func(ctx context.Context, jobName string, ji *JobInvocation) error
+
+// AfterRunHook is called after each job run, once the outcome of the invocation is recorded.
+type AfterRunHook func(ctx context.Context, jobName string, ji *JobInvocation)
@@ -35,6 +35,8 @@ type Config struct {
 type HistoryConfig struct {
 	MaxCount int           `json:"maxCount" yaml:"maxCount" env:"CRON_MAX_COUNT"`
 	MaxAge   time.Duration `json:"maxAge" yaml:"maxAge" env:"CRON_MAX_AGE"`
+	// RecordSkippedDisabled records a history entry when a job's schedule fires while the job is disabled.
+	RecordSkippedDisabled bool `json:"recordSkippedDisabled" yaml:"recordSkippedDisabled" env:"CRON_RECORD_SKIPPED_DISABLED"`
 }
 
 // MaxCountOrDefault returns the max count or a default.
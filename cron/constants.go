@@ -31,8 +31,28 @@ const (
 	DefaultShouldWriteOutput = true
 	// DefaultShouldTriggerListeners is a default.
 	DefaultShouldTriggerListeners = true
+	// DefaultConcurrencyOverflow is a default.
+	DefaultConcurrencyOverflow = ConcurrencyOverflowQueue
+	// DefaultMaxQueuedRuns is a default.
+	DefaultMaxQueuedRuns = 16
 )
 
+// ConcurrencyOverflow is what happens to a run that would exceed a job's max concurrent runs.
+type ConcurrencyOverflow string
+
+// ConcurrencyOverflow values.
+const (
+	// ConcurrencyOverflowQueue waits for an active run to finish before starting the run.
+	ConcurrencyOverflowQueue ConcurrencyOverflow = "queue"
+	// ConcurrencyOverflowDrop skips the run.
+	ConcurrencyOverflowDrop ConcurrencyOverflow = "drop"
+)
+
+// IsValid returns if the concurrency overflow is a known value.
+func (co ConcurrencyOverflow) IsValid() bool {
+	return co == ConcurrencyOverflowQueue || co == ConcurrencyOverflowDrop
+}
+
 const (
 	// FlagStarted is an event flag.
 	FlagStarted logger.Flag = "cron.started"
@@ -50,6 +70,8 @@ const (
 	FlagEnabled logger.Flag = "cron.enabled"
 	// FlagDisabled is an event flag.
 	FlagDisabled logger.Flag = "cron.disabled"
+	// FlagAutoDisabled is an event flag.
+	FlagAutoDisabled logger.Flag = "cron.auto_disabled"
 )
 
 // State is a job state.
@@ -73,4 +95,7 @@ const (
 	JobStatusCancelled JobStatus = "cancelled"
 	JobStatusFailed    JobStatus = "failed"
 	JobStatusComplete  JobStatus = "complete"
+	// JobStatusSkippedDisabled is recorded when a job's schedule fires while the job is disabled.
+	// It is only recorded if `HistoryConfig.RecordSkippedDisabled` is set.
+	JobStatusSkippedDisabled JobStatus = "skipped_disabled"
 )
@@ -14,6 +14,12 @@ const (
 
 	// ErrJobCancelled is a common error.
 	ErrJobCancelled exception.Class = "job cancelled"
+
+	// ErrStateInvalid is returned when an imported job manager state is invalid.
+	ErrStateInvalid exception.Class = "job manager state invalid"
+
+	// ErrConcurrencyOverflowInvalid is returned when a job's concurrency overflow is not a known value.
+	ErrConcurrencyOverflowInvalid exception.Class = "job concurrency overflow invalid"
 )
 
 // IsJobNotLoaded returns if the error is a job not loaded error.
@@ -35,3 +41,13 @@ func IsJobNotFound(err error) bool {
 func IsJobCancelled(err error) bool {
 	return exception.Is(err, ErrJobCancelled)
 }
+
+// IsStateInvalid returns if the error is an invalid job manager state error.
+func IsStateInvalid(err error) bool {
+	return exception.Is(err, ErrStateInvalid)
+}
+
+// IsConcurrencyOverflowInvalid returns if the error is an invalid concurrency overflow error.
+func IsConcurrencyOverflowInvalid(err error) bool {
+	return exception.Is(err, ErrConcurrencyOverflowInvalid)
+}
@@ -53,6 +53,44 @@ type EnabledProvider interface {
 	Enabled() bool
 }
 
+// MaxConsecutiveFailuresProvider is an optional interface that sets the number of consecutive failures
+// after which a job is automatically disabled. A value of zero or less never disables the job.
+type MaxConsecutiveFailuresProvider interface {
+	MaxConsecutiveFailures() int
+}
+
+// MaxConcurrentRunsProvider is an optional interface that limits how many runs of a job can be active at once.
+// A value of zero or less is unlimited.
+type MaxConcurrentRunsProvider interface {
+	MaxConcurrentRuns() int
+}
+
+// ConcurrencyOverflowProvider is an optional interface that sets if runs past a job's max concurrent runs
+// are queued until an active run finishes, or dropped.
+type ConcurrencyOverflowProvider interface {
+	ConcurrencyOverflow() ConcurrencyOverflow
+}
+
+// MaxQueuedRunsProvider is an optional interface that limits how many runs of a job can be queued
+// waiting for an active run to finish. Runs past the limit are dropped. A value of zero or less uses the default.
+type MaxQueuedRunsProvider interface {
+	MaxQueuedRuns() int
+}
+
+// ContextFactoryProvider is an optional interface that allows a job to enrich the context
+// before each run, e.g. with a transaction or a tenant id.
+// The returned cleanup func, if any, is called after the run finishes.
+// If it returns an error, the run is aborted and recorded as a failure.
+type ContextFactoryProvider interface {
+	ContextFactory(context.Context) (context.Context, func(), error)
+}
+
+// TagsProvider is an optional interface that allows a job to provide metadata tags,
+// e.g. an owner or team, that are surfaced in status and metrics.
+type TagsProvider interface {
+	Tags() map[string]string
+}
+
 // OnStartReceiver is an interface that allows a task to be signaled when it has started.
 type OnStartReceiver interface {
 	OnStart(context.Context)
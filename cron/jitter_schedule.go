@@ -0,0 +1,65 @@
+package cron
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Interface assertions.
+var (
+	_ Schedule = (*JitterSchedule)(nil)
+)
+
+// WithJitter returns a schedule that offsets each next runtime of a base schedule
+// by a random duration in [0, max), so jobs on the same schedule don't all fire at once.
+// The jitter is recomputed for each occurrence.
+func WithJitter(base Schedule, max time.Duration) *JitterSchedule {
+	return &JitterSchedule{
+		Schedule: base,
+		Max:      max,
+	}
+}
+
+// JitterSchedule offsets the next runtimes of a schedule by a random jitter.
+type JitterSchedule struct {
+	sync.Mutex
+	Schedule Schedule
+	Max      time.Duration
+
+	// random returns a random number in [0, n); it defaults to `rand.Int63n`.
+	random func(n int64) int64
+
+	lastBase time.Time
+	lastNext time.Time
+}
+
+// Next implements Schedule.
+// The base schedule is passed the un-jittered runtime that preceded `after`, so a jitter
+// that carries a runtime past the base schedule's next runtime does not skip it.
+func (js *JitterSchedule) Next(after time.Time) time.Time {
+	js.Lock()
+	defer js.Unlock()
+
+	from := after
+	if !after.IsZero() && after.Equal(js.lastNext) {
+		from = js.lastBase
+	}
+	base := js.Schedule.Next(from)
+	if base.IsZero() {
+		return Zero
+	}
+	next := base.Add(js.jitter())
+	js.lastBase, js.lastNext = base, next
+	return next
+}
+
+func (js *JitterSchedule) jitter() time.Duration {
+	if js.Max <= 0 {
+		return 0
+	}
+	if js.random != nil {
+		return time.Duration(js.random(int64(js.Max)))
+	}
+	return time.Duration(rand.Int63n(int64(js.Max)))
+}
@@ -0,0 +1,58 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestJitterSchedule(t *testing.T) {
+	assert := assert.New(t)
+
+	base := DailyAtUTC(12, 0, 0)
+	schedule := WithJitter(base, time.Hour)
+	after := time.Date(2019, 01, 02, 03, 04, 05, 0, time.UTC)
+	noon := time.Date(2019, 01, 02, 12, 0, 0, 0, time.UTC)
+
+	for x := 0; x < 10; x++ {
+		next := schedule.Next(after)
+		assert.False(next.Before(noon))
+		assert.True(next.Before(noon.Add(time.Hour)))
+	}
+
+	assert.Equal(noon, WithJitter(base, 0).Next(after), "a zero max should not offset the runtime")
+}
+
+func TestJitterScheduleRecomputed(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int64
+	schedule := WithJitter(DailyAtUTC(12, 0, 0), time.Hour)
+	schedule.random = func(n int64) int64 {
+		calls++
+		return calls * int64(time.Minute)
+	}
+
+	first := schedule.Next(time.Date(2019, 01, 02, 03, 04, 05, 0, time.UTC))
+	assert.Equal(time.Date(2019, 01, 02, 12, 1, 0, 0, time.UTC), first)
+	second := schedule.Next(first)
+	assert.Equal(time.Date(2019, 01, 03, 12, 2, 0, 0, time.UTC), second, "the jitter should be recomputed for each occurrence")
+}
+
+func TestJitterScheduleDoesNotSkip(t *testing.T) {
+	assert := assert.New(t)
+
+	// the jitter is larger than the period of the base schedule.
+	schedule := WithJitter(DailyAtUTC(12, 0, 0), 36*time.Hour)
+	schedule.random = func(n int64) int64 { return n - 1 }
+
+	next := schedule.Next(time.Date(2019, 01, 02, 03, 04, 05, 0, time.UTC))
+	assert.Equal(time.Date(2019, 01, 04, 0, 0, 0, 0, time.UTC).Add(-1), next)
+	for day := 3; day < 6; day++ {
+		next = schedule.Next(next)
+		assert.Equal(time.Date(2019, 01, day, 12, 0, 0, 0, time.UTC).Add(36*time.Hour-1), next, "no occurrence of the base schedule should be skipped")
+	}
+
+	assert.True(WithJitter(OnceAtUTC(time.Date(2019, 01, 02, 0, 0, 0, 0, time.UTC)), time.Hour).Next(time.Date(2019, 01, 03, 0, 0, 0, 0, time.UTC)).IsZero())
+}
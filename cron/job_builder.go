@@ -9,7 +9,13 @@ import (
 var (
 	_ ScheduleProvider               = (*JobBuilder)(nil)
 	_ TimeoutProvider                = (*JobBuilder)(nil)
+	_ TagsProvider                   = (*JobBuilder)(nil)
 	_ EnabledProvider                = (*JobBuilder)(nil)
+	_ MaxConsecutiveFailuresProvider = (*JobBuilder)(nil)
+	_ MaxConcurrentRunsProvider      = (*JobBuilder)(nil)
+	_ ConcurrencyOverflowProvider    = (*JobBuilder)(nil)
+	_ MaxQueuedRunsProvider          = (*JobBuilder)(nil)
+	_ ContextFactoryProvider         = (*JobBuilder)(nil)
 	_ ShouldWriteOutputProvider      = (*JobBuilder)(nil)
 	_ ShouldTriggerListenersProvider = (*JobBuilder)(nil)
 	_ OnStartReceiver                = (*JobBuilder)(nil)
@@ -33,12 +39,18 @@ func NewJob(name string, action Action) *JobBuilder {
 // JobBuilder allows for job creation w/o a fully formed struct.
 type JobBuilder struct {
 	name                           string
+	tags                           map[string]string
 	timeoutProvider                func() time.Duration
 	enabledProvider                func() bool
 	shouldTriggerListenersProvider func() bool
 	shouldWriteOutputProvider      func() bool
+	maxConsecutiveFailures         int
+	maxConcurrentRuns              int
+	concurrencyOverflow            ConcurrencyOverflow
+	maxQueuedRuns                  int
 	schedule                       Schedule
 	action                         Action
+	contextFactory                 ContextFactory
 
 	onStart        func(*JobInvocation)
 	onCancellation func(*JobInvocation)
@@ -57,6 +69,12 @@ func (jb *JobBuilder) WithName(name string) *JobBuilder {
 	return jb
 }
 
+// WithTags sets the job tags.
+func (jb *JobBuilder) WithTags(tags map[string]string) *JobBuilder {
+	jb.tags = tags
+	return jb
+}
+
 // WithSchedule sets the schedule for the job.
 func (jb *JobBuilder) WithSchedule(schedule Schedule) *JobBuilder {
 	jb.schedule = schedule
@@ -81,6 +99,37 @@ func (jb *JobBuilder) WithEnabledProvider(enabledProvider func() bool) *JobBuild
 	return jb
 }
 
+// WithContextFactory sets a factory that enriches the context before each run.
+// The cleanup func it returns, if any, is called after the run finishes.
+func (jb *JobBuilder) WithContextFactory(factory ContextFactory) *JobBuilder {
+	jb.contextFactory = factory
+	return jb
+}
+
+// WithMaxConsecutiveFailures sets the number of consecutive failures after which the job is automatically disabled.
+func (jb *JobBuilder) WithMaxConsecutiveFailures(maxConsecutiveFailures int) *JobBuilder {
+	jb.maxConsecutiveFailures = maxConsecutiveFailures
+	return jb
+}
+
+// WithMaxConcurrentRuns sets the maximum number of runs of the job that can be active at once.
+func (jb *JobBuilder) WithMaxConcurrentRuns(maxConcurrentRuns int) *JobBuilder {
+	jb.maxConcurrentRuns = maxConcurrentRuns
+	return jb
+}
+
+// WithConcurrencyOverflow sets if runs past the max concurrent runs are queued or dropped.
+func (jb *JobBuilder) WithConcurrencyOverflow(overflow ConcurrencyOverflow) *JobBuilder {
+	jb.concurrencyOverflow = overflow
+	return jb
+}
+
+// WithMaxQueuedRuns sets the maximum number of runs of the job that can be queued past the max concurrent runs.
+func (jb *JobBuilder) WithMaxQueuedRuns(maxQueuedRuns int) *JobBuilder {
+	jb.maxQueuedRuns = maxQueuedRuns
+	return jb
+}
+
 // WithShouldTriggerListenersProvider sets the enabled provider for the job.
 func (jb *JobBuilder) WithShouldTriggerListenersProvider(provider func() bool) *JobBuilder {
 	jb.shouldTriggerListenersProvider = provider
@@ -150,6 +199,11 @@ func (jb *JobBuilder) Name() string {
 	return jb.name
 }
 
+// Tags returns the job tags.
+func (jb *JobBuilder) Tags() map[string]string {
+	return jb.tags
+}
+
 // Schedule returns the job schedule.
 func (jb *JobBuilder) Schedule() Schedule {
 	return jb.schedule
@@ -171,6 +225,40 @@ func (jb *JobBuilder) Enabled() bool {
 	return true
 }
 
+// ContextFactory enriches the context before a run with the context factory, if one is set.
+func (jb *JobBuilder) ContextFactory(ctx context.Context) (context.Context, func(), error) {
+	if jb.contextFactory != nil {
+		return jb.contextFactory(ctx)
+	}
+	return ctx, nil, nil
+}
+
+// MaxConsecutiveFailures returns the number of consecutive failures after which the job is automatically disabled.
+func (jb *JobBuilder) MaxConsecutiveFailures() int {
+	return jb.maxConsecutiveFailures
+}
+
+// MaxConcurrentRuns returns the maximum number of runs of the job that can be active at once.
+func (jb *JobBuilder) MaxConcurrentRuns() int {
+	return jb.maxConcurrentRuns
+}
+
+// ConcurrencyOverflow returns if runs past the max concurrent runs are queued or dropped.
+func (jb *JobBuilder) ConcurrencyOverflow() ConcurrencyOverflow {
+	if jb.concurrencyOverflow != "" {
+		return jb.concurrencyOverflow
+	}
+	return DefaultConcurrencyOverflow
+}
+
+// MaxQueuedRuns returns the maximum number of runs of the job that can be queued past the max concurrent runs.
+func (jb *JobBuilder) MaxQueuedRuns() int {
+	if jb.maxQueuedRuns > 0 {
+		return jb.maxQueuedRuns
+	}
+	return DefaultMaxQueuedRuns
+}
+
 // ShouldWriteOutput implements the should write output provider.
 func (jb *JobBuilder) ShouldWriteOutput() bool {
 	if jb.shouldWriteOutputProvider != nil {
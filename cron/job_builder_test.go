@@ -22,6 +22,8 @@ func TestJobBuilder(t *testing.T) {
 	assert.True(NewJob("test_job", noop).ShouldWriteOutput())
 	assert.Equal("test_job", NewJob("test_job", noop).Name())
 	assert.Equal("test_job2", NewJob("test_job", noop).WithName("test_job2").Name())
+	assert.Empty(NewJob("test_job", noop).Tags())
+	assert.Equal("platform", NewJob("test_job", noop).WithTags(map[string]string{"owner": "platform"}).Tags()["owner"])
 	assert.Equal(EveryMinute(), NewJob("test_job", noop).WithSchedule(EveryMinute()).Schedule())
 	assert.Equal(time.Second, NewJob("test_job", noop).WithTimeoutProvider(func() time.Duration { return time.Second }).Timeout())
 	action := Action(func(ctx context.Context) error { return nil })
@@ -3,8 +3,10 @@ package cron
 // NOTE: ALL TIMES ARE IN UTC. JUST USE UTC.
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/blend/go-sdk/async"
 	"github.com/blend/go-sdk/exception"
@@ -47,10 +49,18 @@ func MustNewFromEnv() *JobManager {
 type JobManager struct {
 	sync.Mutex
 	latch  *async.Latch
+	paused int32
 	cfg    *Config
 	tracer Tracer
 	log    logger.Log
 	jobs   map[string]*JobScheduler
+
+	subscribersLock sync.Mutex
+	subscribers     map[chan StateChange]struct{}
+
+	hooksLock   sync.Mutex
+	beforeHooks []BeforeRunHook
+	afterHooks  []AfterRunHook
 }
 
 // WithLogger sets the logger and returns a reference to the job manager.
@@ -104,7 +114,10 @@ func (jm *JobManager) LoadJobs(jobs ...Job) error {
 		if _, hasJob := jm.jobs[jobName]; hasJob {
 			return exception.New(ErrJobAlreadyLoaded).WithMessagef("job: %s", job.Name())
 		}
-		jm.jobs[jobName] = NewJobScheduler(jm.cfg, job).WithTracer(jm.tracer).WithLogger(jm.log)
+		if err := validateJob(job); err != nil {
+			return err
+		}
+		jm.jobs[jobName] = jm.newJobScheduler(job)
 	}
 	return nil
 }
@@ -118,10 +131,148 @@ func (jm *JobManager) LoadJob(job Job) error {
 	if _, hasJob := jm.jobs[jobName]; hasJob {
 		return exception.New(ErrJobAlreadyLoaded).WithMessagef("job: %s", job.Name())
 	}
-	jm.jobs[jobName] = NewJobScheduler(jm.cfg, job).WithTracer(jm.tracer).WithLogger(jm.log)
+	if err := validateJob(job); err != nil {
+		return err
+	}
+	jm.jobs[jobName] = jm.newJobScheduler(job)
+	return nil
+}
+
+// Reload replaces the loaded jobs with a given set of jobs.
+// Jobs that are not in the new set are stopped and unloaded, jobs that are new are loaded,
+// and jobs that were already loaded are replaced, keeping their state: if they're disabled and why,
+// their failure streak, history and counts, and their active runs, which finish on the replacement.
+// If the job manager is running, the new job schedulers are started.
+// If the new set is invalid (e.g. it has duplicate names or an unknown concurrency overflow),
+// the loaded jobs are left untouched.
+func (jm *JobManager) Reload(jobs ...Job) error {
+	jm.Lock()
+	defer jm.Unlock()
+
+	reloaded := map[string]*JobScheduler{}
+	for _, job := range jobs {
+		jobName := job.Name()
+		if _, hasJob := reloaded[jobName]; hasJob {
+			return exception.New(ErrJobAlreadyLoaded).WithMessagef("job: %s", jobName)
+		}
+		if err := validateJob(job); err != nil {
+			return err
+		}
+		reloaded[jobName] = jm.newJobScheduler(job)
+	}
+
+	for jobName, existing := range jm.jobs {
+		existing.Stop()
+		if js, hasJob := reloaded[jobName]; hasJob {
+			existing.handOff(js)
+		}
+	}
+
+	jm.jobs = reloaded
+	if jm.latch.IsRunning() {
+		for _, js := range jm.jobs {
+			js.Start()
+		}
+	}
 	return nil
 }
 
+// validateJob returns an error if a job's optional settings are invalid.
+func validateJob(job Job) error {
+	if typed, ok := job.(ConcurrencyOverflowProvider); ok {
+		if overflow := typed.ConcurrencyOverflow(); !overflow.IsValid() {
+			return exception.New(ErrConcurrencyOverflowInvalid).WithMessagef("job: %s, concurrency overflow: %q", job.Name(), overflow)
+		}
+	}
+	return nil
+}
+
+// newJobScheduler returns a job scheduler for a job that is wired to the job manager.
+func (jm *JobManager) newJobScheduler(job Job) *JobScheduler {
+	js := NewJobScheduler(jm.cfg, job).WithTracer(jm.tracer).WithLogger(jm.log)
+	js.PausedProvider = jm.IsPaused
+	js.StateChangeListener = jm.publishStateChange
+	js.BeforeRun = jm.runBeforeHooks
+	js.AfterRun = jm.runAfterHooks
+	return js
+}
+
+// OnBeforeRun adds a hook that is called before every job run, in the order the hooks were added.
+// If a hook returns an error, the run is aborted and recorded as failed, and the remaining hooks are not called.
+func (jm *JobManager) OnBeforeRun(hook BeforeRunHook) {
+	jm.hooksLock.Lock()
+	defer jm.hooksLock.Unlock()
+	jm.beforeHooks = append(jm.beforeHooks, hook)
+}
+
+// OnAfterRun adds a hook that is called after every job run, in the order the hooks were added.
+// The hooks are only called for runs that were not aborted by a before run hook.
+func (jm *JobManager) OnAfterRun(hook AfterRunHook) {
+	jm.hooksLock.Lock()
+	defer jm.hooksLock.Unlock()
+	jm.afterHooks = append(jm.afterHooks, hook)
+}
+
+func (jm *JobManager) runBeforeHooks(ctx context.Context, jobName string, ji *JobInvocation) error {
+	jm.hooksLock.Lock()
+	hooks := append([]BeforeRunHook(nil), jm.beforeHooks...)
+	jm.hooksLock.Unlock()
+
+	for _, hook := range hooks {
+		if err := hook(ctx, jobName, ji); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (jm *JobManager) runAfterHooks(ctx context.Context, jobName string, ji *JobInvocation) {
+	jm.hooksLock.Lock()
+	hooks := append([]AfterRunHook(nil), jm.afterHooks...)
+	jm.hooksLock.Unlock()
+
+	for _, hook := range hooks {
+		hook(ctx, jobName, ji)
+	}
+}
+
+// Subscribe returns a channel of job state changes, and a func that unsubscribes and closes the channel.
+// Changes are dropped for the subscriber, instead of blocking the job, if the channel's buffer is full.
+func (jm *JobManager) Subscribe(bufferSize int) (<-chan StateChange, func()) {
+	changes := make(chan StateChange, bufferSize)
+
+	jm.subscribersLock.Lock()
+	if jm.subscribers == nil {
+		jm.subscribers = map[chan StateChange]struct{}{}
+	}
+	jm.subscribers[changes] = struct{}{}
+	jm.subscribersLock.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			jm.subscribersLock.Lock()
+			delete(jm.subscribers, changes)
+			jm.subscribersLock.Unlock()
+			close(changes)
+		})
+	}
+	return changes, unsubscribe
+}
+
+// publishStateChange sends a state change to the subscribers, dropping it for subscribers that are full.
+func (jm *JobManager) publishStateChange(change StateChange) {
+	jm.subscribersLock.Lock()
+	defer jm.subscribersLock.Unlock()
+
+	for subscriber := range jm.subscribers {
+		select {
+		case subscriber <- change:
+		default:
+		}
+	}
+}
+
 // DisableJobs disables a variadic list of job names.
 func (jm *JobManager) DisableJobs(jobNames ...string) error {
 	jm.Lock()
@@ -173,7 +324,7 @@ func (jm *JobManager) EnableJob(jobName string) error {
 	if !ok {
 		return exception.New(ErrJobNotFound).WithMessagef("job: %s", jobName)
 	}
-	job.Disable()
+	job.Enable()
 	return nil
 }
 
@@ -217,7 +368,7 @@ func (jm *JobManager) IsJobRunning(jobName string) (isRunning bool) {
 	defer jm.Unlock()
 
 	if job, ok := jm.jobs[jobName]; ok {
-		isRunning = job.Current != nil
+		isRunning = len(job.ActiveInvocations()) > 0
 	}
 	return
 }
@@ -285,8 +436,8 @@ func (jm *JobManager) Status() *Status {
 	for _, job := range jm.jobs {
 		status.Jobs = append(status.Jobs, job)
 
-		if job.Current != nil {
-			status.Running[job.Name] = append(status.Running[job.Name], job.Current)
+		if active := job.ActiveInvocations(); len(active) > 0 {
+			status.Running[job.Name] = active
 		}
 	}
 	return &status
@@ -323,6 +474,23 @@ func (jm *JobManager) Stop() error {
 	return nil
 }
 
+// Pause stops the job schedulers from starting new scheduled runs.
+// Jobs that are currently running are left to finish, and jobs can still be run on demand.
+// The enabled or disabled state of each job is left as is.
+func (jm *JobManager) Pause() {
+	atomic.StoreInt32(&jm.paused, 1)
+}
+
+// Resume lets the job schedulers start scheduled runs again after a `Pause`.
+func (jm *JobManager) Resume() {
+	atomic.StoreInt32(&jm.paused, 0)
+}
+
+// IsPaused returns if the job manager is paused.
+func (jm *JobManager) IsPaused() bool {
+	return atomic.LoadInt32(&jm.paused) == 1
+}
+
 // NotifyStarted returns the started notification channel.
 func (jm *JobManager) NotifyStarted() <-chan struct{} {
 	return jm.latch.NotifyStarted()
@@ -0,0 +1,192 @@
+package cron
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/blend/go-sdk/exception"
+)
+
+// JobManagerState is the exported state of a job manager.
+// It is used to carry job flags, schedules and history across job managers, e.g. for debugging or migrations.
+type JobManagerState struct {
+	Jobs []JobState `json:"jobs"`
+}
+
+// JobState is the exported state of a job.
+type JobState struct {
+	Name     string `json:"name"`
+	Disabled bool   `json:"disabled"`
+	// Schedule is the job's schedule as a cron string, if the schedule was parsed from one.
+	// It is empty for other schedules, and is left unchanged on import if empty.
+	Schedule string               `json:"schedule,omitempty"`
+	History  []JobInvocationState `json:"history,omitempty"`
+}
+
+// JobInvocationState is the exported state of a job invocation.
+type JobInvocationState struct {
+	ID        string        `json:"id"`
+	Started   time.Time     `json:"started"`
+	Finished  time.Time     `json:"finished"`
+	Cancelled time.Time     `json:"cancelled"`
+	Timeout   time.Time     `json:"timeout"`
+	Err       string        `json:"err,omitempty"`
+	Elapsed   time.Duration `json:"elapsed"`
+	Status    JobStatus     `json:"status"`
+}
+
+// ExportState returns the state of the loaded jobs as json.
+// The state includes each job's disabled flag, its schedule expression, and its history.
+func (jm *JobManager) ExportState() ([]byte, error) {
+	jm.Lock()
+	defer jm.Unlock()
+
+	var state JobManagerState
+	for _, jobName := range jm.jobNamesSorted() {
+		state.Jobs = append(state.Jobs, jm.jobs[jobName].exportState())
+	}
+	return json.Marshal(state)
+}
+
+// ImportState applies a state from `ExportState` to the loaded jobs.
+// The whole state is validated before it is applied; if any job isn't loaded or any schedule expression is invalid,
+// an error is returned and no job is changed.
+// Jobs that are loaded but missing from the state are left untouched.
+func (jm *JobManager) ImportState(contents []byte) error {
+	var state JobManagerState
+	if err := json.Unmarshal(contents, &state); err != nil {
+		return exception.New(ErrStateInvalid).WithInner(err)
+	}
+
+	jm.Lock()
+	defer jm.Unlock()
+
+	schedules := map[string]Schedule{}
+	seen := map[string]bool{}
+	for _, jobState := range state.Jobs {
+		if seen[jobState.Name] {
+			return exception.New(ErrStateInvalid).WithMessagef("duplicate job: %s", jobState.Name)
+		}
+		seen[jobState.Name] = true
+
+		js, hasJob := jm.jobs[jobState.Name]
+		if !hasJob {
+			return exception.New(ErrJobNotLoaded).WithMessagef("job: %s", jobState.Name)
+		}
+		if jobState.Schedule == "" || jobState.Schedule == scheduleExpression(js.Schedule) {
+			continue
+		}
+		schedule, err := ParseString(jobState.Schedule)
+		if err != nil {
+			return exception.New(ErrStateInvalid).WithInner(err).WithMessagef("job: %s", jobState.Name)
+		}
+		schedules[jobState.Name] = schedule
+	}
+
+	for _, jobState := range state.Jobs {
+		jm.jobs[jobState.Name].importState(jobState, schedules[jobState.Name])
+	}
+	return nil
+}
+
+// jobNamesSorted returns the loaded job names in order.
+// It must be called while holding the job manager lock.
+func (jm *JobManager) jobNamesSorted() []string {
+	jobNames := make([]string, 0, len(jm.jobs))
+	for jobName := range jm.jobs {
+		jobNames = append(jobNames, jobName)
+	}
+	sort.Strings(jobNames)
+	return jobNames
+}
+
+// exportState returns the exported state of the job.
+func (js *JobScheduler) exportState() JobState {
+	js.Lock()
+	defer js.Unlock()
+
+	jobState := JobState{
+		Name:     js.Name,
+		Disabled: js.Disabled,
+		Schedule: scheduleExpression(js.Schedule),
+	}
+	for _, ji := range js.History {
+		jis := JobInvocationState{
+			ID:        ji.ID,
+			Started:   ji.Started,
+			Finished:  ji.Finished,
+			Cancelled: ji.Cancelled,
+			Timeout:   ji.Timeout,
+			Elapsed:   ji.Elapsed,
+			Status:    ji.Status,
+		}
+		if ji.Err != nil {
+			jis.Err = ji.Err.Error()
+		}
+		jobState.History = append(jobState.History, jis)
+	}
+	return jobState
+}
+
+// importState applies an exported state to the job.
+// If the schedule is set, the job's schedule is replaced and, if the scheduler is running, it is restarted.
+func (js *JobScheduler) importState(jobState JobState, schedule Schedule) {
+	if schedule != nil {
+		wasRunning := js.Latch.IsRunning()
+		js.Stop()
+		js.Schedule = schedule
+		js.NextRuntime = time.Time{}
+		if wasRunning {
+			js.Start()
+		}
+	}
+
+	js.Lock()
+	disabled := js.Disabled
+	js.Unlock()
+	if jobState.Disabled != disabled {
+		if jobState.Disabled {
+			js.Disable()
+		} else {
+			js.Enable()
+		}
+	}
+
+	var history []JobInvocation
+	for _, jis := range jobState.History {
+		ji := JobInvocation{
+			ID:        jis.ID,
+			Name:      js.Name,
+			Started:   jis.Started,
+			Finished:  jis.Finished,
+			Cancelled: jis.Cancelled,
+			Timeout:   jis.Timeout,
+			Elapsed:   jis.Elapsed,
+			Status:    jis.Status,
+		}
+		if jis.Err != "" {
+			ji.Err = errors.New(jis.Err)
+		}
+		history = append(history, ji)
+	}
+
+	js.Lock()
+	defer js.Unlock()
+	js.History = history
+	if len(history) > 0 {
+		last := history[len(history)-1]
+		js.Last = &last
+	} else {
+		js.Last = nil
+	}
+}
+
+// scheduleExpression returns the cron string for a schedule, if it was parsed from one.
+func scheduleExpression(schedule Schedule) string {
+	if typed, ok := schedule.(*StringSchedule); ok {
+		return typed.Original
+	}
+	return ""
+}
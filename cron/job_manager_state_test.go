@@ -0,0 +1,75 @@
+package cron
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestJobManagerExportImportState(t *testing.T) {
+	assert := assert.New(t)
+
+	hourly, err := ParseString("0 * * * *")
+	assert.Nil(err)
+
+	jm := New()
+	assert.Nil(jm.LoadJobs(NewJob("test-0", noop).WithSchedule(hourly), NewJob("test-1", noop)))
+	js, err := jm.Job("test-0")
+	assert.Nil(err)
+	js.addHistory(JobInvocation{ID: "ji-0", Name: "test-0", Started: time.Now().UTC(), Status: JobStatusFailed, Err: fmt.Errorf("failed")})
+
+	exported, err := jm.ExportState()
+	assert.Nil(err)
+
+	var state JobManagerState
+	assert.Nil(json.Unmarshal(exported, &state))
+	assert.Len(state.Jobs, 2)
+	assert.Equal("test-0", state.Jobs[0].Name)
+	assert.Equal("0 * * * *", state.Jobs[0].Schedule)
+	assert.Len(state.Jobs[0].History, 1)
+	assert.Equal("failed", state.Jobs[0].History[0].Err)
+	assert.Empty(state.Jobs[1].Schedule)
+
+	assert.Nil(jm.DisableJob("test-0"))
+	js.History = nil
+	assert.True(jm.IsJobDisabled("test-0"))
+
+	assert.Nil(jm.ImportState(exported))
+	assert.False(jm.IsJobDisabled("test-0"), "the imported state should restore the disabled flag")
+	assert.Len(js.History, 1)
+	assert.Equal("ji-0", js.History[0].ID)
+	assert.Equal("failed", js.History[0].Err.Error())
+	assert.NotNil(js.Last)
+	assert.Equal(hourly, js.Schedule, "an unchanged schedule should be kept")
+
+	state.Jobs[1].Schedule = "0 0 * * *"
+	changed, err := json.Marshal(state)
+	assert.Nil(err)
+	assert.Nil(jm.ImportState(changed))
+	other, err := jm.Job("test-1")
+	assert.Nil(err)
+	assert.Equal("0 0 * * *", scheduleExpression(other.Schedule))
+}
+
+func TestJobManagerImportStateInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	assert.Nil(jm.LoadJobs(NewJob("test-0", noop), NewJob("test-1", noop)))
+
+	err := jm.ImportState([]byte(`{"jobs":[{"name":"test-0","disabled":true},{"name":"not-loaded"}]}`))
+	assert.True(IsJobNotLoaded(err))
+	assert.False(jm.IsJobDisabled("test-0"), "no job should be changed if the state is invalid")
+
+	err = jm.ImportState([]byte(`{"jobs":[{"name":"test-0","disabled":true},{"name":"test-1","schedule":"not a schedule"}]}`))
+	assert.True(IsStateInvalid(err))
+	assert.False(jm.IsJobDisabled("test-0"), "no job should be changed if the state is invalid")
+
+	err = jm.ImportState([]byte(`{"jobs":[{"name":"test-0"},{"name":"test-0"}]}`))
+	assert.True(IsStateInvalid(err))
+
+	assert.True(IsStateInvalid(jm.ImportState([]byte(`not json`))))
+}
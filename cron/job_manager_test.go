@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -65,6 +66,45 @@ func TestRunJobBySchedule(t *testing.T) {
 	a.True(Since(before) < 2*DefaultHeartbeatInterval)
 }
 
+func TestJobManagerPause(t *testing.T) {
+	assert := assert.New(t)
+
+	var runs int32
+	jm := New()
+	assert.Nil(jm.LoadJob(NewJob("test0", func(_ context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	}).WithSchedule(Every(5*time.Millisecond))))
+	assert.Nil(jm.LoadJob(NewJob("test1", func(_ context.Context) error { return nil })))
+	assert.Nil(jm.DisableJob("test1"))
+
+	assert.Nil(jm.Start())
+	defer jm.Stop()
+
+	for atomic.LoadInt32(&runs) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	jm.Pause()
+	assert.True(jm.IsPaused())
+	assert.True(jm.IsRunning(), "pausing should not stop the job manager")
+
+	// let any run that was starting as the manager paused finish.
+	time.Sleep(20 * time.Millisecond)
+	paused := atomic.LoadInt32(&runs)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(paused, atomic.LoadInt32(&runs), "no new scheduled runs should start while paused")
+	assert.True(jm.IsJobDisabled("test1"), "pausing should leave the disabled state of jobs as is")
+	assert.False(jm.IsJobDisabled("test0"))
+
+	jm.Resume()
+	assert.False(jm.IsPaused())
+	for atomic.LoadInt32(&runs) == paused {
+		time.Sleep(time.Millisecond)
+	}
+	assert.True(jm.IsJobDisabled("test1"))
+}
+
 func TestDisableJob(t *testing.T) {
 	a := assert.New(t)
 
@@ -76,6 +116,30 @@ func TestDisableJob(t *testing.T) {
 	a.True(jm.IsJobDisabled(runAtJobName))
 }
 
+func TestEnableJob(t *testing.T) {
+	a := assert.New(t)
+
+	jm := New()
+	a.Nil(jm.LoadJob(NewJob("test", func(_ context.Context) error {
+		return fmt.Errorf("this is only a test")
+	}).WithMaxConsecutiveFailures(2)))
+
+	js, err := jm.Job("test")
+	a.Nil(err)
+	js.Run()
+	js.Run()
+	a.True(jm.IsJobDisabled("test"))
+	a.True(js.AutoDisabled)
+
+	a.Nil(jm.EnableJob("test"))
+	a.False(jm.IsJobDisabled("test"))
+	a.False(js.AutoDisabled)
+	a.Empty(js.DisabledReason)
+	a.Zero(js.ConsecutiveFailures, "enabling the job should reset the failure streak")
+
+	a.NotNil(jm.EnableJob("not-a-job"))
+}
+
 // The goal with this test is to see if panics take down the test process or not.
 func TestJobManagerJobPanicHandling(t *testing.T) {
 	assert := assert.New(t)
@@ -358,6 +422,116 @@ func TestJobManagerLoadJobs(t *testing.T) {
 	assert.NotNil(jm.LoadJobs(NewJob("test-0", noop), NewJob("test-0", noop)))
 }
 
+func TestJobManagerReload(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	assert.Nil(jm.LoadJobs(NewJob("test-0", noop), NewJob("test-1", noop)))
+	assert.Nil(jm.DisableJob("test-0"))
+
+	assert.Nil(jm.Reload(NewJob("test-0", noop).WithSchedule(EverySecond()), NewJob("test-2", noop)))
+	assert.Len(jm.jobs, 2)
+	assert.True(jm.HasJob("test-0"))
+	assert.False(jm.HasJob("test-1"))
+	assert.True(jm.HasJob("test-2"))
+
+	job, err := jm.Job("test-0")
+	assert.Nil(err)
+	assert.True(job.Disabled)
+	assert.NotNil(job.Schedule)
+
+	assert.NotNil(jm.Reload(NewJob("test-3", noop), NewJob("test-3", noop)))
+	assert.Len(jm.jobs, 2)
+	assert.True(jm.HasJob("test-0"))
+	assert.True(jm.HasJob("test-2"))
+}
+
+func TestJobManagerLoadJobInvalidConcurrencyOverflow(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	err := jm.LoadJob(NewJob("test-0", noop).WithConcurrencyOverflow("skip"))
+	assert.True(IsConcurrencyOverflowInvalid(err))
+	assert.False(jm.HasJob("test-0"))
+
+	err = jm.LoadJobs(NewJob("test-1", noop).WithConcurrencyOverflow(ConcurrencyOverflowDrop), NewJob("test-2", noop).WithConcurrencyOverflow("Queue"))
+	assert.True(IsConcurrencyOverflowInvalid(err))
+	assert.True(jm.HasJob("test-1"))
+	assert.False(jm.HasJob("test-2"))
+
+	err = jm.Reload(NewJob("test-1", noop), NewJob("test-3", noop).WithConcurrencyOverflow("skip"))
+	assert.True(IsConcurrencyOverflowInvalid(err))
+	assert.True(jm.HasJob("test-1"))
+	assert.False(jm.HasJob("test-3"))
+}
+
+func TestJobManagerReloadAutoDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	failing := func(_ context.Context) error { return fmt.Errorf("this is only a test") }
+	assert.Nil(jm.LoadJob(NewJob("test", failing).WithMaxConsecutiveFailures(2)))
+	js, err := jm.Job("test")
+	assert.Nil(err)
+	js.Run()
+	js.Run()
+	assert.True(js.AutoDisabled)
+
+	assert.Nil(jm.Reload(NewJob("test", failing).WithMaxConsecutiveFailures(2)))
+	reloaded, err := jm.Job("test")
+	assert.Nil(err)
+	assert.True(reloaded != js)
+	assert.True(reloaded.Disabled)
+	assert.True(reloaded.AutoDisabled, "the job should still report it was disabled automatically")
+	assert.Equal(js.DisabledReason, reloaded.DisabledReason)
+	assert.Equal(2, reloaded.ConsecutiveFailures)
+	assert.Equal(2, reloaded.Failures)
+}
+
+func TestJobManagerReloadRunning(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	action := func(_ context.Context) error {
+		started <- struct{}{}
+		<-proceed
+		return nil
+	}
+	assert.Nil(jm.LoadJob(NewJob("test", action).WithMaxConcurrentRuns(1).WithConcurrencyOverflow(ConcurrencyOverflowDrop)))
+	js, err := jm.Job("test")
+	assert.Nil(err)
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		js.Run()
+	}()
+	<-started
+
+	assert.Nil(jm.Reload(NewJob("test", action).WithMaxConcurrentRuns(1).WithConcurrencyOverflow(ConcurrencyOverflowDrop)))
+	reloaded, err := jm.Job("test")
+	assert.Nil(err)
+	assert.True(jm.IsJobRunning("test"), "the run should still be active after the reload")
+	assert.Equal(1, reloaded.Snapshot().ActiveRuns)
+	assert.Len(reloaded.ActiveInvocations(), 1)
+
+	reloaded.Run()
+	assert.Equal(1, reloaded.Snapshot().ActiveRuns, "the run active before the reload should count against the max concurrent runs")
+
+	close(proceed)
+	<-finished
+
+	snapshot := reloaded.Snapshot()
+	assert.Zero(snapshot.ActiveRuns)
+	assert.Empty(reloaded.ActiveInvocations())
+	assert.Equal(1, snapshot.Successes, "the run should finish on the reloaded job")
+	assert.NotNil(snapshot.Last)
+	assert.Len(snapshot.History, 1)
+	assert.False(jm.IsJobRunning("test"))
+}
+
 func TestJobManagerIsRunning(t *testing.T) {
 	assert := assert.New(t)
 
@@ -405,6 +579,21 @@ func TestJobManagerStatus(t *testing.T) {
 	assert.Len(status.Running, 1)
 }
 
+func TestJobManagerStatusTags(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	jm.LoadJob(NewJob("tagged", noop).WithTags(map[string]string{"owner": "platform", "team": "infra"}))
+	jm.LoadJob(NewJob("untagged", noop))
+
+	tags := map[string]map[string]string{}
+	for _, js := range jm.Status().Jobs {
+		tags[js.Name] = js.Tags
+	}
+	assert.Equal(map[string]string{"owner": "platform", "team": "infra"}, tags["tagged"])
+	assert.Empty(tags["untagged"])
+}
+
 func TestJobManagerCancelJob(t *testing.T) {
 	assert := assert.New(t)
 
@@ -452,3 +641,112 @@ func TestJobManagerStatusRunning(t *testing.T) {
 	close(jobShouldProceed)
 	<-jobDidRun
 }
+
+func TestJobManagerSubscribe(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	assert.Nil(jm.LoadJob(NewJob("test", func(_ context.Context) error { return nil })))
+	js, err := jm.Job("test")
+	assert.Nil(err)
+
+	changes, unsubscribe := jm.Subscribe(8)
+	js.Run()
+	assert.Nil(jm.DisableJob("test"))
+
+	var flags []logger.Flag
+	for x := 0; x < 3; x++ {
+		change := <-changes
+		assert.Equal("test", change.JobName)
+		assert.False(change.Timestamp.IsZero())
+		flags = append(flags, change.Flag)
+	}
+	assert.Equal([]logger.Flag{FlagStarted, FlagComplete, FlagDisabled}, flags)
+
+	unsubscribe()
+	unsubscribe()
+	_, ok := <-changes
+	assert.False(ok, "unsubscribing should close the channel")
+
+	js.Run()
+	assert.Empty(jm.subscribers)
+}
+
+func TestJobManagerSubscribeFull(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := New()
+	assert.Nil(jm.LoadJob(NewJob("test", func(_ context.Context) error { return nil })))
+	js, err := jm.Job("test")
+	assert.Nil(err)
+
+	changes, unsubscribe := jm.Subscribe(1)
+	defer unsubscribe()
+
+	js.Run()
+	js.Run()
+	assert.Len(changes, 1, "changes should be dropped instead of blocking the job")
+}
+
+func TestJobManagerRunHooks(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls []string
+	jm := New()
+	assert.Nil(jm.LoadJob(NewJob("test", func(_ context.Context) error {
+		calls = append(calls, "run")
+		return nil
+	})))
+	jm.OnBeforeRun(func(_ context.Context, jobName string, ji *JobInvocation) error {
+		calls = append(calls, "before0:"+jobName)
+		assert.Equal(JobStatusRunning, ji.Status)
+		return nil
+	})
+	jm.OnBeforeRun(func(_ context.Context, _ string, _ *JobInvocation) error {
+		calls = append(calls, "before1")
+		return nil
+	})
+	jm.OnAfterRun(func(_ context.Context, jobName string, ji *JobInvocation) {
+		calls = append(calls, "after0:"+jobName)
+		assert.Equal(JobStatusComplete, ji.Status)
+		assert.False(ji.Finished.IsZero())
+	})
+	jm.OnAfterRun(func(_ context.Context, _ string, _ *JobInvocation) {
+		calls = append(calls, "after1")
+	})
+
+	js, err := jm.Job("test")
+	assert.Nil(err)
+	js.Run()
+	assert.Equal([]string{"before0:test", "before1", "run", "after0:test", "after1"}, calls)
+}
+
+func TestJobManagerRunHooksBeforeError(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls []string
+	jm := New()
+	assert.Nil(jm.LoadJob(NewJob("test", func(_ context.Context) error {
+		calls = append(calls, "run")
+		return nil
+	})))
+	jm.OnBeforeRun(func(_ context.Context, _ string, _ *JobInvocation) error {
+		calls = append(calls, "before0")
+		return fmt.Errorf("lock is held")
+	})
+	jm.OnBeforeRun(func(_ context.Context, _ string, _ *JobInvocation) error {
+		calls = append(calls, "before1")
+		return nil
+	})
+	jm.OnAfterRun(func(_ context.Context, _ string, _ *JobInvocation) {
+		calls = append(calls, "after")
+	})
+
+	js, err := jm.Job("test")
+	assert.Nil(err)
+	js.Run()
+	assert.Equal([]string{"before0"}, calls, "the job and the remaining hooks should not have been called")
+	assert.NotNil(js.Last)
+	assert.Equal(JobStatusFailed, js.Last.Status)
+	assert.Contains(fmt.Sprintf("%v", js.Last.Err), "lock is held")
+}
@@ -2,6 +2,8 @@ package cron
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -23,6 +25,10 @@ func NewJobScheduler(cfg *Config, job Job) *JobScheduler {
 		js.Schedule = typed.Schedule()
 	}
 
+	if typed, ok := job.(TagsProvider); ok {
+		js.Tags = typed.Tags()
+	}
+
 	if typed, ok := job.(TimeoutProvider); ok {
 		js.TimeoutProvider = typed.Timeout
 	} else {
@@ -41,6 +47,34 @@ func NewJobScheduler(cfg *Config, job Job) *JobScheduler {
 		js.SerialProvider = func() bool { return DefaultSerial }
 	}
 
+	if typed, ok := job.(ContextFactoryProvider); ok {
+		js.ContextFactory = typed.ContextFactory
+	}
+
+	if typed, ok := job.(MaxConsecutiveFailuresProvider); ok {
+		js.MaxConsecutiveFailuresProvider = typed.MaxConsecutiveFailures
+	} else {
+		js.MaxConsecutiveFailuresProvider = func() int { return 0 }
+	}
+
+	if typed, ok := job.(MaxConcurrentRunsProvider); ok {
+		js.MaxConcurrentRunsProvider = typed.MaxConcurrentRuns
+	} else {
+		js.MaxConcurrentRunsProvider = func() int { return 0 }
+	}
+
+	if typed, ok := job.(ConcurrencyOverflowProvider); ok {
+		js.ConcurrencyOverflowProvider = typed.ConcurrencyOverflow
+	} else {
+		js.ConcurrencyOverflowProvider = func() ConcurrencyOverflow { return DefaultConcurrencyOverflow }
+	}
+
+	if typed, ok := job.(MaxQueuedRunsProvider); ok {
+		js.MaxQueuedRunsProvider = typed.MaxQueuedRuns
+	} else {
+		js.MaxQueuedRunsProvider = func() int { return DefaultMaxQueuedRuns }
+	}
+
 	if typed, ok := job.(ShouldTriggerListenersProvider); ok {
 		js.ShouldTriggerListenersProvider = typed.ShouldTriggerListeners
 	} else {
@@ -61,8 +95,9 @@ type JobScheduler struct {
 	sync.Mutex `json:"-"`
 	Latch      *async.Latch `json:"-"`
 
-	Name string `json:"name"`
-	Job  Job    `json:"-"`
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags,omitempty"`
+	Job  Job               `json:"-"`
 
 	Tracer Tracer     `json:"-"`
 	Log    logger.Log `json:"-"`
@@ -75,12 +110,43 @@ type JobScheduler struct {
 	Last        *JobInvocation  `json:"last"`
 	History     []JobInvocation `json:"history"`
 
-	Schedule                       Schedule             `json:"-"`
-	EnabledProvider                func() bool          `json:"-"`
-	SerialProvider                 func() bool          `json:"-"`
-	TimeoutProvider                func() time.Duration `json:"-"`
-	ShouldTriggerListenersProvider func() bool          `json:"-"`
-	ShouldWriteOutputProvider      func() bool          `json:"-"`
+	// Successes is the number of runs of the job that have completed successfully.
+	Successes int64 `json:"successes"`
+	// Failures is the number of runs of the job that have failed.
+	Failures int64 `json:"failures"`
+	// ConsecutiveFailures is the number of times the job has failed in a row.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+	// AutoDisabled is set if the job was disabled because it failed too many times in a row.
+	AutoDisabled bool `json:"autoDisabled,omitempty"`
+	// DisabledReason is why the job was automatically disabled.
+	DisabledReason string `json:"disabledReason,omitempty"`
+	// ActiveRuns is the number of runs of the job currently active.
+	ActiveRuns int `json:"activeRuns"`
+	// QueuedRuns is the number of runs of the job waiting for an active run to finish.
+	QueuedRuns int `json:"queuedRuns"`
+	// runFinished is signalled when an active run finishes, or the job is disabled, to wake queued runs.
+	runFinished *sync.Cond
+	// active are the invocations currently running by id; `Current` is the most recently started of them.
+	active map[string]*JobInvocation
+	// replacedBy is the scheduler that replaced this one when jobs were reloaded.
+	// Runs still active on this scheduler record their state on it.
+	replacedBy *JobScheduler
+
+	Schedule                       Schedule                   `json:"-"`
+	EnabledProvider                func() bool                `json:"-"`
+	PausedProvider                 func() bool                `json:"-"`
+	SerialProvider                 func() bool                `json:"-"`
+	MaxConsecutiveFailuresProvider func() int                 `json:"-"`
+	MaxConcurrentRunsProvider      func() int                 `json:"-"`
+	ConcurrencyOverflowProvider    func() ConcurrencyOverflow `json:"-"`
+	MaxQueuedRunsProvider          func() int                 `json:"-"`
+	ContextFactory                 ContextFactory             `json:"-"`
+	BeforeRun                      BeforeRunHook              `json:"-"`
+	AfterRun                       AfterRunHook               `json:"-"`
+	TimeoutProvider                func() time.Duration       `json:"-"`
+	ShouldTriggerListenersProvider func() bool                `json:"-"`
+	ShouldWriteOutputProvider      func() bool                `json:"-"`
+	StateChangeListener            func(StateChange)          `json:"-"`
 }
 
 // WithTracer sets the scheduler tracer.
@@ -115,16 +181,21 @@ func (js *JobScheduler) Stop() {
 }
 
 // Enable sets the job as enabled.
+// It also resets the consecutive failure count.
 func (js *JobScheduler) Enable() {
 	js.Lock()
 	defer js.Unlock()
 
 	js.Disabled = false
+	js.AutoDisabled = false
+	js.DisabledReason = ""
+	js.ConsecutiveFailures = 0
 	if js.Log != nil && js.ShouldTriggerListenersProvider() {
 		event := NewEvent(FlagEnabled, js.Name).
 			WithIsWritable(js.ShouldWriteOutputProvider())
 		js.Log.Trigger(event)
 	}
+	js.stateChanged(FlagEnabled, nil)
 	if typed, ok := js.Job.(OnEnabledReceiver); ok {
 		typed.OnEnabled(context.Background())
 	}
@@ -136,33 +207,139 @@ func (js *JobScheduler) Disable() {
 	defer js.Unlock()
 
 	js.Disabled = true
+	// wake any queued runs so they are dropped.
+	if js.runFinished != nil {
+		js.runFinished.Broadcast()
+	}
 	if js.Log != nil && js.ShouldTriggerListenersProvider() {
 		event := NewEvent(FlagDisabled, js.Name).
 			WithIsWritable(js.ShouldWriteOutputProvider())
 		js.Log.Trigger(event)
 	}
+	js.stateChanged(FlagDisabled, nil)
 	if typed, ok := js.Job.(OnDisabledReceiver); ok {
 		typed.OnDisabled(context.Background())
 	}
 }
 
-// Cancel stops an execution in process.
+// Cancel stops any executions in process.
 func (js *JobScheduler) Cancel() {
-	if js.Current != nil {
-		js.Current.Cancel()
+	js.Lock()
+	defer js.Unlock()
+
+	for _, ji := range js.active {
+		if ji.Cancel != nil {
+			ji.Cancel()
+		}
+	}
+}
+
+// handOff moves the state of the scheduler to the scheduler replacing it when jobs are reloaded.
+// Runs still active on this scheduler count against the replacement and finish on it;
+// runs queued on this scheduler are dropped.
+func (js *JobScheduler) handOff(next *JobScheduler) {
+	js.Lock()
+	defer js.Unlock()
+	next.Lock()
+	defer next.Unlock()
+
+	next.Disabled = js.Disabled
+	next.AutoDisabled = js.AutoDisabled
+	next.DisabledReason = js.DisabledReason
+	next.ConsecutiveFailures = js.ConsecutiveFailures
+	next.Current = js.Current
+	next.Last = js.Last
+	next.History = js.History
+	next.Successes = js.Successes
+	next.Failures = js.Failures
+	next.ActiveRuns = js.ActiveRuns
+	if len(js.active) > 0 {
+		next.active = map[string]*JobInvocation{}
+		for id, ji := range js.active {
+			next.active[id] = ji
+		}
+	}
+
+	js.replacedBy = next
+	if js.runFinished != nil {
+		js.runFinished.Broadcast()
 	}
 }
 
+// lockCurrent locks and returns the scheduler that run state is recorded on; this is the scheduler itself,
+// or, if it was replaced when jobs were reloaded, its replacement. The caller must unlock it.
+func (js *JobScheduler) lockCurrent() *JobScheduler {
+	js.Lock()
+	if next := js.replacedBy; next != nil {
+		js.Unlock()
+		return next.lockCurrent()
+	}
+	return js
+}
+
+// Snapshot returns a copy of the scheduler's status fields, including copies of its invocations,
+// that is safe to read or encode while the job is running.
+func (js *JobScheduler) Snapshot() *JobScheduler {
+	js.Lock()
+	defer js.Unlock()
+
+	snapshot := &JobScheduler{
+		Name:                js.Name,
+		Tags:                js.Tags,
+		Disabled:            js.Disabled,
+		NextRuntime:         js.NextRuntime,
+		History:             append([]JobInvocation(nil), js.History...),
+		Successes:           js.Successes,
+		Failures:            js.Failures,
+		ConsecutiveFailures: js.ConsecutiveFailures,
+		AutoDisabled:        js.AutoDisabled,
+		DisabledReason:      js.DisabledReason,
+		ActiveRuns:          js.ActiveRuns,
+		QueuedRuns:          js.QueuedRuns,
+	}
+	if len(js.active) > 0 {
+		snapshot.active = map[string]*JobInvocation{}
+		for id, ji := range js.active {
+			active := *ji
+			snapshot.active[id] = &active
+			if ji == js.Current {
+				snapshot.Current = &active
+			}
+		}
+	}
+	if js.Last != nil {
+		last := *js.Last
+		snapshot.Last = &last
+	}
+	return snapshot
+}
+
+// ActiveInvocations returns the invocations currently running, in the order they started.
+func (js *JobScheduler) ActiveInvocations() []*JobInvocation {
+	js.Lock()
+	defer js.Unlock()
+
+	active := make([]*JobInvocation, 0, len(js.active))
+	for _, ji := range js.active {
+		active = append(active, ji)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Started.Before(active[j].Started)
+	})
+	return active
+}
+
 // RunLoop is the main scheduler loop.
 // it alarms on the next runtime and forks a new routine to run the job.
 // It can be aborted with the scheduler's async.Latch.
 func (js *JobScheduler) RunLoop() {
-	js.Latch.Started()
-
 	if js.Schedule != nil {
 		// sniff the schedule, see if a next runtime is called for (or if the job is on demand).
-		js.NextRuntime = js.Schedule.Next(js.NextRuntime)
+		// this happens before the latch is marked started so `Start` returns with the schedule computed.
+		js.setNextRuntime(js.Schedule.Next(js.NextRuntime))
 	}
+	js.Latch.Started()
+
 	if js.NextRuntime.IsZero() {
 		js.Latch.Stopped()
 		return
@@ -176,10 +353,16 @@ func (js *JobScheduler) RunLoop() {
 		runAt := time.After(js.NextRuntime.UTC().Sub(Now()))
 		select {
 		case <-runAt:
-			// start the job
-			go js.Run()
+			// start the job, unless scheduled runs are paused
+			if js.PausedProvider == nil || !js.PausedProvider() {
+				if js.isDisabled() {
+					js.onSkippedDisabled()
+				} else {
+					go js.Run()
+				}
+			}
 			// set up the next runtime.
-			js.NextRuntime = js.Schedule.Next(js.NextRuntime)
+			js.setNextRuntime(js.Schedule.Next(js.NextRuntime))
 		case <-js.Latch.NotifyStopping():
 			js.Latch.Stopped()
 			return
@@ -190,12 +373,19 @@ func (js *JobScheduler) RunLoop() {
 // Run forces the job to run.
 // It checks if the job should be allowed to execute.
 // It blocks on the job execution to enforce or clear timeouts.
+// If the job is at its max concurrent runs, it either waits for an active run to finish or returns, per the job's concurrency overflow.
 func (js *JobScheduler) Run() {
 	// check if the job can run
 	if !js.canRun() {
 		return
 	}
 
+	// wait for, or drop the run if there isn't, a free slot under the max concurrent runs.
+	if !js.acquireRun() {
+		return
+	}
+	defer js.releaseRun()
+
 	// mark the start time
 	start := Now()
 
@@ -217,10 +407,12 @@ func (js *JobScheduler) Run() {
 	if timeout > 0 {
 		ji.Timeout = start.Add(timeout)
 	}
-	js.setCurrent(&ji)
+	js.addActive(&ji)
 
 	var err error
 	var tf TraceFinisher
+	var cleanup func()
+	var beforeRan bool
 	// load the job invocation into the context
 	ctx = WithJobInvocation(ctx, &ji)
 
@@ -233,6 +425,11 @@ func (js *JobScheduler) Run() {
 		if r := recover(); r != nil {
 			err = exception.New(err)
 		}
+		// the invocation is no longer active before it is updated, so snapshots never see it change.
+		js.removeActive(&ji)
+		if cleanup != nil {
+			cleanup()
+		}
 		cancel()
 		if tf != nil {
 			tf.Finish(ctx)
@@ -242,20 +439,47 @@ func (js *JobScheduler) Run() {
 		ji.Elapsed = ji.Finished.Sub(ji.Started)
 		ji.Err = err
 
+		var flag logger.Flag
 		if err != nil && IsJobCancelled(err) {
 			ji.Cancelled = ji.Finished
+			flag = FlagCancelled
 			js.onCancelled(ctx, &ji)
 		} else if ji.Err != nil {
+			flag = FlagFailed
 			js.onFailure(ctx, &ji)
 		} else {
+			flag = FlagComplete
 			js.onComplete(ctx, &ji)
 		}
 
 		js.addHistory(ji)
-		js.setCurrent(nil)
 		js.setLast(&ji)
+		js.stateChanged(flag, &ji)
+
+		if beforeRan && js.AfterRun != nil {
+			js.AfterRun(ctx, js.Name, &ji)
+		}
 	}()
 
+	// if the context factory is set, enrich the context before the run.
+	if js.ContextFactory != nil {
+		factoryCtx, factoryCleanup, factoryErr := js.ContextFactory(ctx)
+		if factoryErr != nil {
+			err = exception.New(factoryErr)
+			return
+		}
+		ctx, cleanup = factoryCtx, factoryCleanup
+	}
+
+	// if the before run hook is set, it can abort the run.
+	if js.BeforeRun != nil {
+		if hookErr := js.BeforeRun(ctx, js.Name, &ji); hookErr != nil {
+			err = exception.New(hookErr)
+			return
+		}
+	}
+	beforeRan = true
+
 	// if the tracer is set, create a trace context
 	if js.Tracer != nil {
 		ctx, tf = js.Tracer.Start(ctx)
@@ -276,16 +500,47 @@ func (js *JobScheduler) Run() {
 // utility functions
 //
 
-func (js *JobScheduler) setCurrent(ji *JobInvocation) {
+// setNextRuntime sets the next runtime; it is only called by the run loop, which can read it without the lock.
+func (js *JobScheduler) setNextRuntime(nextRuntime time.Time) {
 	js.Lock()
-	js.Current = ji
+	js.NextRuntime = nextRuntime
 	js.Unlock()
 }
 
+// addActive records an invocation as running, and makes it the current invocation.
+func (js *JobScheduler) addActive(ji *JobInvocation) {
+	current := js.lockCurrent()
+	defer current.Unlock()
+
+	if current.active == nil {
+		current.active = map[string]*JobInvocation{}
+	}
+	current.active[ji.ID] = ji
+	current.Current = ji
+}
+
+// removeActive records an invocation as finished.
+// If it was the current invocation, the most recently started invocation still running becomes current.
+func (js *JobScheduler) removeActive(ji *JobInvocation) {
+	current := js.lockCurrent()
+	defer current.Unlock()
+
+	delete(current.active, ji.ID)
+	if current.Current != ji {
+		return
+	}
+	current.Current = nil
+	for _, active := range current.active {
+		if current.Current == nil || active.Started.After(current.Current.Started) {
+			current.Current = active
+		}
+	}
+}
+
 func (js *JobScheduler) setLast(ji *JobInvocation) {
-	js.Lock()
-	js.Last = ji
-	js.Unlock()
+	current := js.lockCurrent()
+	current.Last = ji
+	current.Unlock()
 }
 
 // safeAsyncExec runs a given job's body and recovers panics.
@@ -309,29 +564,100 @@ func (js *JobScheduler) createContextWithTimeout(timeout time.Duration) (context
 	return context.WithCancel(context.Background())
 }
 
+// isDisabled returns if a job is disabled, either explicitly or by its enabled provider.
+func (js *JobScheduler) isDisabled() bool {
+	js.Lock()
+	defer js.Unlock()
+	return js.isDisabledUnsafe()
+}
+
+func (js *JobScheduler) isDisabledUnsafe() bool {
+	if js.Disabled {
+		return true
+	}
+	if js.EnabledProvider != nil {
+		if !js.EnabledProvider() {
+			return true
+		}
+	}
+	return false
+}
+
 // canRun returns if a job can execute.
 func (js *JobScheduler) canRun() bool {
 	js.Lock()
 	defer js.Unlock()
 
-	if js.Disabled {
+	if js.replacedBy != nil || js.isDisabledUnsafe() {
 		return false
 	}
 
-	if js.EnabledProvider != nil {
-		if !js.EnabledProvider() {
+	if js.SerialProvider != nil && js.SerialProvider() {
+		if js.ActiveRuns > 0 {
 			return false
 		}
 	}
+	return true
+}
 
-	if js.SerialProvider != nil && js.SerialProvider() {
-		if js.Current != nil {
+// acquireRun counts a run as active, first waiting for an active run to finish if the job
+// is at its max concurrent runs. It returns false if the run should be dropped instead,
+// i.e. if the overflow is to drop runs, too many runs are already queued, or the job was disabled
+// or the scheduler was replaced while the run was queued.
+func (js *JobScheduler) acquireRun() bool {
+	js.Lock()
+	defer js.Unlock()
+
+	if js.replacedBy != nil {
+		return false
+	}
+
+	for js.MaxConcurrentRunsProvider != nil {
+		maxConcurrentRuns := js.MaxConcurrentRunsProvider()
+		if maxConcurrentRuns <= 0 || js.ActiveRuns < maxConcurrentRuns {
+			break
+		}
+		if js.ConcurrencyOverflowProvider != nil && js.ConcurrencyOverflowProvider() == ConcurrencyOverflowDrop {
+			return false
+		}
+		if js.QueuedRuns >= js.maxQueuedRuns() {
+			return false
+		}
+		if js.runFinished == nil {
+			js.runFinished = sync.NewCond(&js.Mutex)
+		}
+		js.QueuedRuns++
+		js.runFinished.Wait()
+		js.QueuedRuns--
+		if js.replacedBy != nil || js.isDisabledUnsafe() {
 			return false
 		}
 	}
+	js.ActiveRuns++
 	return true
 }
 
+// maxQueuedRuns returns the maximum number of queued runs, or the default if it isn't set.
+func (js *JobScheduler) maxQueuedRuns() int {
+	if js.MaxQueuedRunsProvider != nil {
+		if maxQueuedRuns := js.MaxQueuedRunsProvider(); maxQueuedRuns > 0 {
+			return maxQueuedRuns
+		}
+	}
+	return DefaultMaxQueuedRuns
+}
+
+// releaseRun counts an active run as finished, waking any queued runs.
+func (js *JobScheduler) releaseRun() {
+	current := js.lockCurrent()
+	defer current.Unlock()
+
+	current.ActiveRuns--
+	if current.runFinished != nil {
+		current.runFinished.Broadcast()
+	}
+}
+
 func (js *JobScheduler) onStart(ctx context.Context, ji *JobInvocation) {
 	if js.Log != nil && js.ShouldTriggerListenersProvider() {
 		event := NewEvent(FlagStarted, ji.Name).
@@ -339,6 +665,7 @@ func (js *JobScheduler) onStart(ctx context.Context, ji *JobInvocation) {
 			WithIsWritable(js.ShouldWriteOutputProvider())
 		js.Log.Trigger(event)
 	}
+	js.stateChanged(FlagStarted, ji)
 	if typed, ok := js.Job.(OnStartReceiver); ok {
 		typed.OnStart(ctx)
 	}
@@ -361,6 +688,10 @@ func (js *JobScheduler) onCancelled(ctx context.Context, ji *JobInvocation) {
 
 func (js *JobScheduler) onComplete(ctx context.Context, ji *JobInvocation) {
 	ji.Status = JobStatusComplete
+	current := js.lockCurrent()
+	current.Successes++
+	current.Unlock()
+	js.resetConsecutiveFailures()
 
 	if js.Log != nil && js.ShouldTriggerListenersProvider() {
 		event := NewEvent(FlagComplete, ji.Name).
@@ -390,6 +721,9 @@ func (js *JobScheduler) onComplete(ctx context.Context, ji *JobInvocation) {
 
 func (js *JobScheduler) onFailure(ctx context.Context, ji *JobInvocation) {
 	ji.Status = JobStatusFailed
+	current := js.lockCurrent()
+	current.Failures++
+	current.Unlock()
 
 	if js.Log != nil && js.ShouldTriggerListenersProvider() {
 		event := NewEvent(FlagFailed, ji.Name).
@@ -419,12 +753,87 @@ func (js *JobScheduler) onFailure(ctx context.Context, ji *JobInvocation) {
 			typed.OnBroken(ctx)
 		}
 	}
+	if js.shouldAutoDisable() {
+		js.autoDisable(ji)
+	}
+}
+
+func (js *JobScheduler) resetConsecutiveFailures() {
+	current := js.lockCurrent()
+	current.ConsecutiveFailures = 0
+	current.Unlock()
+}
+
+// shouldAutoDisable counts a failure, and returns if the job has now failed
+// more times in a row than its max consecutive failures.
+func (js *JobScheduler) shouldAutoDisable() bool {
+	current := js.lockCurrent()
+	defer current.Unlock()
+
+	current.ConsecutiveFailures++
+	if current.Disabled || current.MaxConsecutiveFailuresProvider == nil {
+		return false
+	}
+	maxConsecutiveFailures := current.MaxConsecutiveFailuresProvider()
+	return maxConsecutiveFailures > 0 && current.ConsecutiveFailures >= maxConsecutiveFailures
+}
+
+// autoDisable disables the job after too many consecutive failures, recording why.
+func (js *JobScheduler) autoDisable(ji *JobInvocation) {
+	current := js.lockCurrent()
+	current.AutoDisabled = true
+	current.DisabledReason = fmt.Sprintf("disabled after %d consecutive failures, last error: %v", current.ConsecutiveFailures, ji.Err)
+	current.Unlock()
+
+	if js.Log != nil && js.ShouldTriggerListenersProvider() {
+		event := NewEvent(FlagAutoDisabled, ji.Name).
+			WithJobInvocation(ji.ID).
+			WithIsWritable(js.ShouldWriteOutputProvider()).
+			WithErr(ji.Err)
+		js.Log.Trigger(event)
+	}
+	js.stateChanged(FlagAutoDisabled, ji)
+	current.Disable()
+}
+
+// stateChanged notifies the state change listener of a change, if one is set.
+// Changes for invocations finishing are sent once the history and last invocation are updated.
+func (js *JobScheduler) stateChanged(flag logger.Flag, ji *JobInvocation) {
+	if js.StateChangeListener == nil {
+		return
+	}
+	change := StateChange{
+		Flag:      flag,
+		JobName:   js.Name,
+		Timestamp: Now(),
+	}
+	if ji != nil {
+		change.JobInvocation = ji.ID
+	}
+	js.StateChangeListener(change)
+}
+
+// onSkippedDisabled records that the schedule fired while the job was disabled, if enabled by the config.
+func (js *JobScheduler) onSkippedDisabled() {
+	if js.Config == nil || !js.Config.History.RecordSkippedDisabled {
+		return
+	}
+	now := Now()
+	js.addHistory(JobInvocation{
+		ID:       NewJobInvocationID(),
+		Name:     js.Name,
+		Started:  now,
+		Finished: now,
+		Status:   JobStatusSkippedDisabled,
+	})
 }
 
 func (js *JobScheduler) addHistory(ji JobInvocation) {
-	js.Lock()
-	defer js.Unlock()
-	js.History = append(js.cullHistory(), ji)
+	current := js.lockCurrent()
+	defer current.Unlock()
+	// cull after adding the invocation, so the history never exceeds the max count.
+	current.History = append(current.History, ji)
+	current.History = current.cullHistory()
 }
 
 func (js *JobScheduler) cullHistory() []JobInvocation {
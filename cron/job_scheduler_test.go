@@ -2,6 +2,8 @@ package cron
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -89,3 +91,387 @@ func TestJobSchedulerEnableDisable(t *testing.T) {
 	assert.True(disabled)
 	assert.True(enabled)
 }
+
+func TestJobSchedulerRecordSkippedDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	js := NewJobScheduler(&Config{
+		History: HistoryConfig{
+			MaxCount:              5,
+			RecordSkippedDisabled: true,
+		},
+	}, NewJob("foo", noop).WithSchedule(Every(time.Millisecond)))
+	js.Disable()
+	js.Start()
+
+	deadline := time.Now().Add(time.Second)
+	var history []JobInvocation
+	for time.Now().Before(deadline) {
+		js.Lock()
+		history = append([]JobInvocation{}, js.History...)
+		js.Unlock()
+		if len(history) > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	js.Stop()
+
+	assert.NotEmpty(history)
+	assert.Equal(JobStatusSkippedDisabled, history[0].Status)
+	assert.Equal("foo", history[0].Name)
+
+	js.Lock()
+	defer js.Unlock()
+	assert.True(len(js.History) <= 5)
+}
+
+func TestJobSchedulerSkippedDisabledNotRecordedByDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	js := NewJobScheduler(&Config{}, NewJob("foo", noop).WithSchedule(Every(time.Millisecond)))
+	js.Disable()
+	js.Start()
+	time.Sleep(20 * time.Millisecond)
+	js.Stop()
+
+	js.Lock()
+	defer js.Unlock()
+	assert.Empty(js.History)
+}
+
+func TestJobSchedulerAutoDisable(t *testing.T) {
+	assert := assert.New(t)
+
+	var runs, disabled int
+	fail := true
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		runs++
+		if fail {
+			return fmt.Errorf("this is only a test")
+		}
+		return nil
+	}).WithMaxConsecutiveFailures(3).WithOnDisabled(func(_ context.Context) {
+		disabled++
+	}))
+
+	js.Run()
+	js.Run()
+	assert.Equal(2, js.ConsecutiveFailures)
+	assert.False(js.Disabled)
+
+	fail = false
+	js.Run()
+	assert.Zero(js.ConsecutiveFailures, "a success should reset the count")
+
+	fail = true
+	js.Run()
+	js.Run()
+	assert.False(js.Disabled)
+	js.Run()
+	assert.Equal(3, js.ConsecutiveFailures)
+	assert.True(js.Disabled)
+	assert.True(js.AutoDisabled)
+	assert.Equal("disabled after 3 consecutive failures, last error: this is only a test", js.DisabledReason)
+	assert.Equal(1, disabled)
+
+	js.Run()
+	assert.Equal(6, runs, "the job should not run once disabled")
+
+	js.Enable()
+	assert.False(js.Disabled)
+	assert.False(js.AutoDisabled)
+	assert.Empty(js.DisabledReason)
+	assert.Zero(js.ConsecutiveFailures)
+
+	js.Run()
+	assert.Equal(7, runs)
+	assert.Equal(1, js.ConsecutiveFailures)
+	assert.False(js.Disabled)
+}
+
+func TestJobSchedulerAutoDisableUnset(t *testing.T) {
+	assert := assert.New(t)
+
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		return fmt.Errorf("this is only a test")
+	}))
+	for x := 0; x < 10; x++ {
+		js.Run()
+	}
+	assert.Equal(10, js.ConsecutiveFailures)
+	assert.False(js.Disabled)
+	assert.False(js.AutoDisabled)
+}
+
+type contextFactoryTestKey struct{}
+
+func TestJobSchedulerContextFactory(t *testing.T) {
+	assert := assert.New(t)
+
+	var value interface{}
+	var cleanedUp bool
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(ctx context.Context) error {
+		value = ctx.Value(contextFactoryTestKey{})
+		assert.NotNil(GetJobInvocation(ctx), "the job invocation should still be on the context")
+		assert.False(cleanedUp, "cleanup should run after the job")
+		return nil
+	}).WithContextFactory(func(ctx context.Context) (context.Context, func(), error) {
+		return context.WithValue(ctx, contextFactoryTestKey{}, "tenant"), func() { cleanedUp = true }, nil
+	}))
+
+	js.Run()
+	assert.Equal("tenant", value)
+	assert.True(cleanedUp)
+	assert.NotNil(js.Last)
+	assert.Equal(JobStatusComplete, js.Last.Status)
+}
+
+func TestJobSchedulerContextFactoryError(t *testing.T) {
+	assert := assert.New(t)
+
+	var ran bool
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		ran = true
+		return nil
+	}).WithContextFactory(func(_ context.Context) (context.Context, func(), error) {
+		return nil, nil, fmt.Errorf("this is only a test")
+	}))
+
+	js.Run()
+	assert.False(ran)
+	assert.NotNil(js.Last)
+	assert.Equal(JobStatusFailed, js.Last.Status)
+	assert.NotNil(js.Last.Err)
+	assert.Equal("this is only a test", js.Last.Err.Error())
+	assert.Len(js.History, 1)
+}
+
+func TestJobSchedulerMaxConcurrentRunsQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	}).WithMaxConcurrentRuns(1))
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	for x := 0; x < 3; x++ {
+		go func() {
+			defer wg.Done()
+			js.Run()
+		}()
+	}
+
+	for x := 0; x < 3; x++ {
+		<-started
+		select {
+		case <-started:
+			assert.FailNow("should not have started another run while one is active")
+		case <-time.After(10 * time.Millisecond):
+		}
+		js.Lock()
+		assert.Equal(1, js.ActiveRuns)
+		js.Unlock()
+		release <- struct{}{}
+	}
+	wg.Wait()
+
+	assert.Zero(js.ActiveRuns)
+	assert.Len(js.History, 3)
+}
+
+func TestJobSchedulerMaxConcurrentRunsDrop(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	}).WithMaxConcurrentRuns(1).WithConcurrencyOverflow(ConcurrencyOverflowDrop))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		js.Run()
+	}()
+	<-started
+
+	// the second run is dropped, so it returns without running.
+	js.Run()
+	assert.Empty(started)
+	js.Lock()
+	assert.Equal(1, js.ActiveRuns)
+	assert.Empty(js.History)
+	js.Unlock()
+
+	close(release)
+	<-done
+	assert.Zero(js.ActiveRuns)
+	assert.Len(js.History, 1)
+}
+
+func TestJobSchedulerConcurrentRunsActive(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 2)
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return nil
+	}))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for x := 0; x < 2; x++ {
+		go func() {
+			defer wg.Done()
+			js.Run()
+		}()
+	}
+	<-started
+	<-started
+
+	active := js.ActiveInvocations()
+	assert.Len(active, 2)
+	assert.NotEqual(active[0].ID, active[1].ID)
+	js.Lock()
+	assert.NotNil(js.Current)
+	js.Unlock()
+
+	js.Cancel()
+	wg.Wait()
+
+	assert.Empty(js.ActiveInvocations())
+	assert.Nil(js.Current)
+	assert.Len(js.History, 2)
+	for _, ji := range js.History {
+		assert.Equal(JobStatusCancelled, ji.Status, "every active run should be cancelled")
+	}
+}
+
+func TestJobSchedulerMaxQueuedRuns(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 4)
+	release := make(chan struct{})
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	}).WithMaxConcurrentRuns(1).WithMaxQueuedRuns(1))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		js.Run()
+	}()
+	<-started
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		js.Run()
+	}()
+	for {
+		js.Lock()
+		queued := js.QueuedRuns
+		js.Unlock()
+		if queued == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// the queue is full, so these runs are dropped.
+	js.Run()
+	js.Run()
+
+	close(release)
+	wg.Wait()
+	assert.Len(js.History, 2)
+	assert.Zero(js.QueuedRuns)
+}
+
+func TestJobSchedulerQueuedRunDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	}).WithMaxConcurrentRuns(1))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		js.Run()
+	}()
+	<-started
+
+	queued := make(chan struct{})
+	go func() {
+		defer close(queued)
+		js.Run()
+	}()
+	for {
+		js.Lock()
+		count := js.QueuedRuns
+		js.Unlock()
+		if count == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	js.Disable()
+	<-queued
+	assert.Empty(started, "a run queued before the job was disabled should be dropped")
+
+	close(release)
+	<-done
+	assert.Len(js.History, 1)
+}
+
+func TestJobSchedulerSnapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	js := NewJobScheduler(&Config{}, NewJob("foo", func(_ context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		js.Run()
+	}()
+	<-started
+
+	snapshot := js.Snapshot()
+	assert.Equal("foo", snapshot.Name)
+	assert.Equal(1, snapshot.ActiveRuns)
+	assert.NotNil(snapshot.Current)
+	assert.Len(snapshot.ActiveInvocations(), 1)
+	assert.Equal(snapshot.Current, snapshot.ActiveInvocations()[0])
+
+	close(release)
+	<-done
+
+	assert.Equal(JobStatusRunning, snapshot.Current.Status, "the snapshot should not change as the run finishes")
+	assert.True(snapshot.Current.Finished.IsZero())
+	assert.Nil(snapshot.Last)
+	assert.NotNil(js.Snapshot().Last)
+}
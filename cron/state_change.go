@@ -0,0 +1,16 @@
+package cron
+
+import (
+	"time"
+
+	"github.com/blend/go-sdk/logger"
+)
+
+// StateChange is a change of a job's state, e.g. an invocation starting or finishing, or the job being disabled.
+// The flag is the flag of the event triggered for the change.
+type StateChange struct {
+	Flag          logger.Flag `json:"flag"`
+	JobName       string      `json:"jobName"`
+	JobInvocation string      `json:"jobInvocation,omitempty"`
+	Timestamp     time.Time   `json:"timestamp"`
+}
@@ -106,6 +106,7 @@ const (
 	ErrStringScheduleComponents      exception.Class = "cron: must have at least (5) components space delimited; ex: '0 0 * * * * *'"
 	ErrStringScheduleValueOutOfRange exception.Class = "cron: string schedule part out of range"
 	ErrStringScheduleInvalidRange    exception.Class = "cron: range (from-to) invalid"
+	ErrStringScheduleInvalidName     exception.Class = "cron: string schedule part name invalid"
 )
 
 // String schedule shorthands labels
@@ -343,7 +344,7 @@ func parsePart(values string, parser func(string) (int, error), validator func(i
 	for x := 0; x < len(components); x++ {
 		component = components[x]
 		if strings.Contains(component, string(cronSpecialDash)) {
-			rangeValues, err := parseRange(values, parser, validator)
+			rangeValues, err := parseRange(component, parser, validator)
 			if err != nil {
 				return nil, err
 			}
@@ -359,7 +360,7 @@ func parsePart(values string, parser func(string) (int, error), validator func(i
 			return nil, exception.New(err)
 		}
 		if validator != nil && !validator(part) {
-			return nil, exception.New(err)
+			return nil, exception.New(ErrStringScheduleValueOutOfRange).WithMessagef("value out of range: %s", component)
 		}
 		output[part] = true
 	}
@@ -386,7 +387,7 @@ func parseRange(values string, parser func(string) (int, error), validator func(
 	parts := strings.Split(values, string(cronSpecialDash))
 
 	if len(parts) != 2 {
-		return nil, exception.New(ErrStringScheduleInvalidRange).WithMessagef("invalid range: %s")
+		return nil, exception.New(ErrStringScheduleInvalidRange).WithMessagef("invalid range: %s", values)
 	}
 
 	from, err := parser(parts[0])
@@ -420,13 +421,14 @@ func parseInt(s string) (int, error) {
 	return strconv.Atoi(s)
 }
 
+// parseMonth parses a month as an integer (1-12) or a case-insensitive name (JAN-DEC).
 func parseMonth(s string) (int, error) {
-	if value, ok := validMonths[s]; ok {
+	if value, ok := validMonths[strings.ToUpper(s)]; ok {
 		return value, nil
 	}
 	value, err := strconv.Atoi(s)
 	if err != nil {
-		return 0, exception.New(err).WithMessage("month not a valid integer")
+		return 0, exception.New(ErrStringScheduleInvalidName).WithMessagef("month not a valid integer (1-12) or name (JAN-DEC): %s", s)
 	}
 	if value < 1 || value > 12 {
 		return 0, exception.New(ErrStringScheduleValueOutOfRange).WithMessagef("month out of range (1-12): %s", s)
@@ -434,13 +436,14 @@ func parseMonth(s string) (int, error) {
 	return value, nil
 }
 
+// parseDayOfWeek parses a day of the week as an integer (0-6) or a case-insensitive name (SUN-SAT).
 func parseDayOfWeek(s string) (int, error) {
-	if value, ok := validDaysOfWeek[s]; ok {
+	if value, ok := validDaysOfWeek[strings.ToUpper(s)]; ok {
 		return value, nil
 	}
 	value, err := strconv.Atoi(s)
 	if err != nil {
-		return 0, exception.New(err).WithMessage("day of week not a valid integer")
+		return 0, exception.New(ErrStringScheduleInvalidName).WithMessagef("day of week not a valid integer (0-6) or name (SUN-SAT): %s", s)
 	}
 	if value < 0 || value > 6 {
 		return 0, exception.New(ErrStringScheduleValueOutOfRange).WithMessagef("day of week out of range (0-6): %s", s)
@@ -36,6 +36,14 @@ func TestParseString(t *testing.T) {
 		{Input: "0 0 * * * *", After: time.Date(2019, 01, 02, 12, 3, 4, 5, time.UTC), Expected: time.Date(2019, 01, 02, 13, 0, 0, 0, time.UTC)},   // every hour on the hour (6 field)
 		{Input: "@daily", After: time.Date(2019, 01, 02, 12, 3, 4, 5, time.UTC), Expected: time.Date(2019, 01, 03, 0, 0, 0, 0, time.UTC)},         // daily shorthand
 		{Input: "@hourly", After: time.Date(2019, 01, 02, 12, 3, 4, 5, time.UTC), Expected: time.Date(2019, 01, 02, 13, 0, 0, 0, time.UTC)},       // hourly shorthand
+		{Input: "0 9 * * FUNDAY", ExpectedErr: ErrStringScheduleInvalid},
+		{Input: "0 0 1 JANUARY *", ExpectedErr: ErrStringScheduleInvalid},
+		{Input: "0 0 1 13 *", ExpectedErr: ErrStringScheduleInvalid},
+		{Input: "0 9 * * MON-FRI", After: time.Date(2019, 01, 04, 10, 0, 0, 0, time.UTC), Expected: time.Date(2019, 01, 07, 9, 0, 0, 0, time.UTC)},     // weekday mornings, from friday
+		{Input: "0 9 * * mon,Wed,FRI", After: time.Date(2019, 01, 01, 12, 0, 0, 0, time.UTC), Expected: time.Date(2019, 01, 02, 9, 0, 0, 0, time.UTC)}, // named list, mixed case
+		{Input: "0 9 * * MON-WED,5", After: time.Date(2019, 01, 03, 12, 0, 0, 0, time.UTC), Expected: time.Date(2019, 01, 04, 9, 0, 0, 0, time.UTC)},   // named range and numeric list
+		{Input: "0 0 1 JAN *", After: time.Date(2019, 01, 02, 12, 3, 4, 5, time.UTC), Expected: time.Date(2020, 01, 01, 0, 0, 0, 0, time.UTC)},         // new year's day
+		{Input: "0 0 1 jan,JUL *", After: time.Date(2019, 01, 02, 12, 3, 4, 5, time.UTC), Expected: time.Date(2019, 07, 01, 0, 0, 0, 0, time.UTC)},     // named month list
 	}
 
 	for _, tc := range testCases {
@@ -51,6 +59,25 @@ func TestParseString(t *testing.T) {
 	}
 }
 
+func TestParseStringNamedWeekdays(t *testing.T) {
+	assert := assert.New(t)
+
+	schedule, err := ParseString("0 9 * * MON-FRI")
+	assert.Nil(err)
+
+	last := time.Date(2019, 01, 06, 12, 0, 0, 0, time.UTC) // a sunday
+	for x := 0; x < 10; x++ {
+		last = schedule.Next(last)
+		assert.False(IsWeekendDay(last.Weekday()), last.Format(time.RFC3339))
+		assert.Equal(9, last.Hour())
+		assert.Zero(last.Minute())
+	}
+	assert.Equal(time.Date(2019, 01, 18, 9, 0, 0, 0, time.UTC), last)
+
+	_, err = ParseString("0 9 * * FUNDAY")
+	assert.Contains(fmt.Sprintf("%v", exception.Inner(err)), "FUNDAY")
+}
+
 func TestStringScheduleEvery(t *testing.T) {
 	assert := assert.New(t)
 
@@ -0,0 +1,57 @@
+package jobkit
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/blend/go-sdk/web"
+)
+
+// authorized returns a middleware that requires the management server credentials set on the config,
+// either as a bearer token or basic auth. If no credentials are set, the action is not changed.
+func authorized(cfg *Config) web.Middleware {
+	return func(action web.Action) web.Action {
+		if !cfg.HasManagementCredentials() {
+			return action
+		}
+		return func(r *web.Ctx) web.Result {
+			if !cfg.IsManagementAuthorized(r.Request()) {
+				r.Response().Header().Set("WWW-Authenticate", `Basic realm="jobkit"`)
+				return web.JSON.Status(http.StatusUnauthorized, "Not Authorized")
+			}
+			return action(r)
+		}
+	}
+}
+
+// HasManagementCredentials returns if credentials are required by the management server.
+func (c Config) HasManagementCredentials() bool {
+	return c.ManagementBearerToken != "" || c.ManagementUsername != ""
+}
+
+// IsManagementAuthorized returns if a request has the credentials required by the management server,
+// as either the bearer token or the basic auth username and password.
+func (c Config) IsManagementAuthorized(req *http.Request) bool {
+	if c.ManagementBearerToken != "" {
+		if header := req.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+			if secureEquals(strings.TrimPrefix(header, "Bearer "), c.ManagementBearerToken) {
+				return true
+			}
+		}
+	}
+	if c.ManagementUsername != "" {
+		if username, password, ok := req.BasicAuth(); ok {
+			// compare both values, so the time taken doesn't tell which one is wrong.
+			usernameEquals := secureEquals(username, c.ManagementUsername)
+			passwordEquals := secureEquals(password, c.ManagementPassword)
+			return usernameEquals && passwordEquals
+		}
+	}
+	return false
+}
+
+// secureEquals compares two strings in constant time.
+func secureEquals(actual, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(actual), []byte(expected)) == 1
+}
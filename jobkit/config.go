@@ -22,6 +22,14 @@ type Config struct {
 	Web    web.Config    `json:"web" yaml:"web"`
 
 	DisableManagementServer bool `json:"disableManagementServer" yaml:"disableManagementServer"`
+	// ManagementBearerToken, if set, is a token the management server api requires as a bearer token.
+	ManagementBearerToken string `json:"managementBearerToken" yaml:"managementBearerToken"`
+	// ManagementUsername, if set, is a username the management server api requires with basic auth.
+	ManagementUsername string `json:"managementUsername" yaml:"managementUsername"`
+	// ManagementPassword is the password the management server api requires with basic auth.
+	ManagementPassword string `json:"managementPassword" yaml:"managementPassword"`
+	// EnablePrometheus enables the prometheus `/metrics` endpoint on the management server.
+	EnablePrometheus bool `json:"enablePrometheus" yaml:"enablePrometheus"`
 
 	Airbrake airbrake.Config `json:"airbrake" yaml:"airbrake"`
 	AWS      aws.Config      `json:"aws" yaml:"aws"`
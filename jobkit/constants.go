@@ -2,5 +2,16 @@ package jobkit
 
 // Constants and Defaults
 const (
+	// DefaultMaxLogBytes is the default maximum amount of log output captured for a job invocation.
 	DefaultMaxLogBytes = 10 * (1 << 10)
+	// DefaultHistoryLimit is the default number of invocations returned by the job history api.
+	DefaultHistoryLimit = 50
+	// DefaultStreamBufferSize is the number of job state changes buffered for each client of the status stream.
+	DefaultStreamBufferSize = 32
+	// ContentTypeEventStream is the content type of server-sent events.
+	ContentTypeEventStream = "text/event-stream"
+	// HealthzPaused is the healthz response when the job manager is running but paused.
+	HealthzPaused = "Paused"
+	// ContentTypePrometheus is the content type of the prometheus text exposition format.
+	ContentTypePrometheus = "text/plain; version=0.0.4; charset=utf-8"
 )
@@ -21,6 +21,7 @@ var indexTemplate = `
 			<tr>
 				<td> <!-- job name -->
 					{{ $job.Name }}
+					<a class="small-text" href="/api/job.logs/{{ $job.Name }}">Logs</a>
 				</td>
 				<td> <!-- job status -->
 				{{ if $job.Disabled }}
@@ -32,6 +33,9 @@ var indexTemplate = `
 				<td> <!-- job status -->
 				{{ if $job.Current }}
 					{{ since_utc $job.Current.Started }}
+					{{ if gt $job.ActiveRuns 1 }}
+						<span class="small-text">({{ $job.ActiveRuns }} active)</span>
+					{{ end }}
 				{{else}}
 					<span>-</span>
 				{{end}}
@@ -68,6 +72,13 @@ var indexTemplate = `
 			<tr>
 				<td colspan=7>
 					<h4>History</h4>
+					<p class="small-text">
+						Show:
+						<a href="/">All</a>
+						<a href="/?status=complete">Complete</a>
+						<a href="/?status=failed">Failed</a>
+						<a href="/?status=cancelled">Cancelled</a>
+					</p>
 					<table class="u-full-width small-text">
 						<thead>
 							<tr>
@@ -93,7 +104,7 @@ var indexTemplate = `
 						</tr>
 						{{ else }}
 						<tr>
-							<td colspan=7>No History</td>
+							<td colspan=7>{{ if $.ViewModel.Status }}No {{ $.ViewModel.Status }} History{{ else }}No History{{ end }}</td>
 						</tr>
 						{{ end }}
 						</tbody>
@@ -3,6 +3,7 @@ package jobkit
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/blend/go-sdk/cron"
@@ -14,30 +15,37 @@ import (
 )
 
 var (
-	_ cron.Job                    = (*Job)(nil)
-	_ cron.TimeoutProvider        = (*Job)(nil)
-	_ cron.ScheduleProvider       = (*Job)(nil)
-	_ cron.OnStartReceiver        = (*Job)(nil)
-	_ cron.OnCompleteReceiver     = (*Job)(nil)
-	_ cron.OnFailureReceiver      = (*Job)(nil)
-	_ cron.OnCancellationReceiver = (*Job)(nil)
-	_ cron.OnBrokenReceiver       = (*Job)(nil)
-	_ cron.OnFixedReceiver        = (*Job)(nil)
-	_ cron.OnDisabledReceiver     = (*Job)(nil)
-	_ cron.OnEnabledReceiver      = (*Job)(nil)
+	_ cron.Job                         = (*Job)(nil)
+	_ cron.TimeoutProvider             = (*Job)(nil)
+	_ cron.ScheduleProvider            = (*Job)(nil)
+	_ cron.TagsProvider                = (*Job)(nil)
+	_ cron.MaxConcurrentRunsProvider   = (*Job)(nil)
+	_ cron.ConcurrencyOverflowProvider = (*Job)(nil)
+	_ cron.MaxQueuedRunsProvider       = (*Job)(nil)
+	_ cron.OnStartReceiver             = (*Job)(nil)
+	_ cron.OnCompleteReceiver          = (*Job)(nil)
+	_ cron.OnFailureReceiver           = (*Job)(nil)
+	_ cron.OnCancellationReceiver      = (*Job)(nil)
+	_ cron.OnBrokenReceiver            = (*Job)(nil)
+	_ cron.OnFixedReceiver             = (*Job)(nil)
+	_ cron.OnDisabledReceiver          = (*Job)(nil)
+	_ cron.OnEnabledReceiver           = (*Job)(nil)
+	_ LogBufferProvider                = (*Job)(nil)
 )
 
 // NewJob creates a new exec job.
 func NewJob(action func(context.Context) error) *Job {
 	return &Job{
-		config: &JobConfig{},
-		action: action,
+		config:     &JobConfig{},
+		action:     action,
+		logBuffers: newLogBuffers(DefaultMaxLogBytes),
 	}
 }
 
 // Job is the main job body.
 type Job struct {
 	name   string
+	tags   map[string]string
 	config *JobConfig
 
 	schedule cron.Schedule
@@ -49,6 +57,8 @@ type Job struct {
 	slackClient slack.Sender
 	emailClient email.Sender
 	errorClient diagnostics.Notifier
+
+	logBuffers *logBuffers
 }
 
 // Name returns the job name.
@@ -65,6 +75,27 @@ func (job *Job) WithName(name string) *Job {
 	return job
 }
 
+// Tags returns the job tags.
+// Tags set with `WithTags` take precedence over tags from the config.
+func (job Job) Tags() map[string]string {
+	tags := map[string]string{}
+	if job.config != nil {
+		for key, value := range job.config.Tags {
+			tags[key] = value
+		}
+	}
+	for key, value := range job.tags {
+		tags[key] = value
+	}
+	return tags
+}
+
+// WithTags sets the tags.
+func (job *Job) WithTags(tags map[string]string) *Job {
+	job.tags = tags
+	return job
+}
+
 // Schedule returns the job schedule.
 func (job Job) Schedule() cron.Schedule {
 	return job.schedule
@@ -98,6 +129,30 @@ func (job *Job) WithTimeout(d time.Duration) *Job {
 	return job
 }
 
+// MaxConcurrentRuns returns the maximum number of runs that can be active at once from the config.
+func (job Job) MaxConcurrentRuns() int {
+	if job.config != nil {
+		return job.config.MaxConcurrentRunsOrDefault()
+	}
+	return 0
+}
+
+// ConcurrencyOverflow returns if runs past the max concurrent runs are queued or dropped from the config.
+func (job Job) ConcurrencyOverflow() cron.ConcurrencyOverflow {
+	if job.config != nil {
+		return job.config.ConcurrencyOverflowOrDefault()
+	}
+	return cron.DefaultConcurrencyOverflow
+}
+
+// MaxQueuedRuns returns the maximum number of runs that can be queued past the max concurrent runs from the config.
+func (job Job) MaxQueuedRuns() int {
+	if job.config != nil {
+		return job.config.MaxQueuedRunsOrDefault()
+	}
+	return cron.DefaultMaxQueuedRuns
+}
+
 // WithLogger sets the job logger.
 func (job *Job) WithLogger(log logger.Log) *Job {
 	job.log = log
@@ -128,6 +183,21 @@ func (job *Job) WithErrorClient(client diagnostics.Notifier) *Job {
 	return job
 }
 
+// WithMaxLogBytes sets the maximum amount of log output captured for each invocation.
+func (job *Job) WithMaxLogBytes(maxBytes int) *Job {
+	job.logBuffers = newLogBuffers(maxBytes)
+	return job
+}
+
+// LogBuffer returns the captured log output of an invocation by id.
+// Only running invocations and the last finished invocation are retained; it returns nil for others.
+func (job Job) LogBuffer(invocationID string) *LogBuffer {
+	if job.logBuffers == nil {
+		return nil
+	}
+	return job.logBuffers.get(invocationID)
+}
+
 // OnStart is a lifecycle event handler.
 func (job Job) OnStart(ctx context.Context) {
 	if job.config != nil && job.config.NotifyOnStartOrDefault() {
@@ -186,9 +256,10 @@ func (job Job) OnDisabled(ctx context.Context) {
 
 func (job Job) notify(ctx context.Context, flag logger.Flag) {
 	if job.statsClient != nil {
-		job.statsClient.Increment(string(flag), fmt.Sprintf("%s:%s", stats.TagJob, job.Name()))
+		tags := job.statsTags()
+		job.statsClient.Increment(string(flag), tags...)
 		if ji := cron.GetJobInvocation(ctx); ji != nil {
-			logger.MaybeError(job.log, job.statsClient.TimeInMilliseconds(string(flag), ji.Elapsed, fmt.Sprintf("%s:%s", stats.TagJob, job.Name())))
+			logger.MaybeError(job.log, job.statsClient.TimeInMilliseconds(string(flag), ji.Elapsed, tags...))
 		}
 	}
 	if job.slackClient != nil {
@@ -212,7 +283,30 @@ func (job Job) notify(ctx context.Context, flag logger.Flag) {
 	}
 }
 
+// statsTags returns the job name tag and the job tags, sorted by key, as stats tags.
+func (job Job) statsTags() []string {
+	jobTags := job.Tags()
+	keys := make([]string, 0, len(jobTags))
+	for key := range jobTags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	tags := []string{fmt.Sprintf("%s:%s", stats.TagJob, job.Name())}
+	for _, key := range keys {
+		tags = append(tags, fmt.Sprintf("%s:%s", key, jobTags[key]))
+	}
+	return tags
+}
+
 // Execute is the job body.
+// Log output written with the jobkit logging helpers is captured in a log buffer for the invocation.
 func (job Job) Execute(ctx context.Context) error {
+	if job.logBuffers != nil {
+		if ji := cron.GetJobInvocation(ctx); ji != nil {
+			ctx = WithLogBuffer(ctx, job.logBuffers.start(ji.ID))
+			defer job.logBuffers.finish(ji.ID)
+		}
+	}
 	return job.action(ctx)
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/blend/go-sdk/configutil"
+	"github.com/blend/go-sdk/cron"
 )
 
 // JobConfig is something you can use to give your jobs some knobs to turn
@@ -16,6 +17,16 @@ type JobConfig struct {
 	Schedule string `json:"schedule" yaml:"schedule"`
 	// Timeout represents the abort threshold for the job.
 	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+	// Jitter is the maximum random offset added to each scheduled runtime, to spread out jobs on the same schedule.
+	Jitter time.Duration `json:"jitter" yaml:"jitter"`
+	// MaxConcurrentRuns is the maximum number of runs of the job that can be active at once; zero or less is unlimited.
+	MaxConcurrentRuns int `json:"maxConcurrentRuns" yaml:"maxConcurrentRuns"`
+	// ConcurrencyOverflow is if runs past the max concurrent runs are queued or dropped, i.e. `queue` or `drop`.
+	ConcurrencyOverflow string `json:"concurrencyOverflow" yaml:"concurrencyOverflow"`
+	// MaxQueuedRuns is the maximum number of runs that can be queued past the max concurrent runs; zero or less uses the default.
+	MaxQueuedRuns int `json:"maxQueuedRuns" yaml:"maxQueuedRuns"`
+	// Tags are metadata tags for the job, e.g. owner or team, surfaced in status and metrics.
+	Tags map[string]string `json:"tags" yaml:"tags"`
 
 	// NotifyOnStart governs if we should send notifications job start.
 	NotifyOnStart *bool `json:"notifyOnStart" yaml:"notifyOnStart"`
@@ -48,6 +59,26 @@ func (jc JobConfig) TimeoutOrDefault() time.Duration {
 	return jc.Timeout
 }
 
+// JitterOrDefault returns the maximum schedule jitter or a default (no jitter).
+func (jc JobConfig) JitterOrDefault() time.Duration {
+	return jc.Jitter
+}
+
+// MaxConcurrentRunsOrDefault returns the maximum concurrent runs or a default (unlimited).
+func (jc JobConfig) MaxConcurrentRunsOrDefault() int {
+	return jc.MaxConcurrentRuns
+}
+
+// ConcurrencyOverflowOrDefault returns the concurrency overflow or a default (queue).
+func (jc JobConfig) ConcurrencyOverflowOrDefault() cron.ConcurrencyOverflow {
+	return cron.ConcurrencyOverflow(configutil.CoalesceString(jc.ConcurrencyOverflow, string(cron.DefaultConcurrencyOverflow)))
+}
+
+// MaxQueuedRunsOrDefault returns the maximum queued runs or a default (`cron.DefaultMaxQueuedRuns`).
+func (jc JobConfig) MaxQueuedRunsOrDefault() int {
+	return configutil.CoalesceInt(jc.MaxQueuedRuns, cron.DefaultMaxQueuedRuns)
+}
+
 // NotifyOnStartOrDefault returns a value or a default.
 func (jc JobConfig) NotifyOnStartOrDefault() bool {
 	return configutil.CoalesceBool(jc.NotifyOnStart, false)
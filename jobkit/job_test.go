@@ -9,6 +9,7 @@ import (
 	"github.com/blend/go-sdk/assert"
 	"github.com/blend/go-sdk/cron"
 	"github.com/blend/go-sdk/slack"
+	"github.com/blend/go-sdk/stats"
 	"github.com/blend/go-sdk/uuid"
 )
 
@@ -33,6 +34,120 @@ func TestJobProperties(t *testing.T) {
 	assert.Equal(time.Second, job.Timeout())
 }
 
+func TestNewJitter(t *testing.T) {
+	assert := assert.New(t)
+
+	job, err := New(&JobConfig{Name: "test0"}, &Config{}, func(_ context.Context) error { return nil })
+	assert.Nil(err)
+	_, isJitter := job.Schedule().(*cron.JitterSchedule)
+	assert.False(isJitter)
+
+	job, err = New(&JobConfig{Name: "test0", Jitter: time.Minute}, &Config{}, func(_ context.Context) error { return nil })
+	assert.Nil(err)
+	typed, isJitter := job.Schedule().(*cron.JitterSchedule)
+	assert.True(isJitter)
+	assert.Equal(time.Minute, typed.Max)
+}
+
+func TestJobMaxConcurrentRuns(t *testing.T) {
+	assert := assert.New(t)
+
+	job := NewJob(func(_ context.Context) error { return nil })
+	assert.Zero(job.MaxConcurrentRuns())
+	assert.Equal(cron.ConcurrencyOverflowQueue, job.ConcurrencyOverflow())
+
+	job.WithConfig(&JobConfig{MaxConcurrentRuns: 2, ConcurrencyOverflow: "drop"})
+	assert.Equal(2, job.MaxConcurrentRuns())
+	assert.Equal(cron.ConcurrencyOverflowDrop, job.ConcurrencyOverflow())
+
+	js := cron.NewJobScheduler(&cron.Config{}, job)
+	assert.Equal(2, js.MaxConcurrentRunsProvider())
+	assert.Equal(cron.ConcurrencyOverflowDrop, js.ConcurrencyOverflowProvider())
+}
+
+func TestJobLogBufferOverlappingRuns(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	job := NewJob(func(ctx context.Context) error {
+		ji := cron.GetJobInvocation(ctx)
+		Infof(ctx, nil, "%s started", ji.ID)
+		if ji.ID == "first" {
+			close(started)
+			<-release
+		}
+		Infof(ctx, nil, "%s finished", ji.ID)
+		return nil
+	})
+	execute := func(id string) error {
+		return job.Execute(cron.WithJobInvocation(context.Background(), &cron.JobInvocation{ID: id}))
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- execute("first")
+	}()
+	<-started
+	assert.Nil(execute("second"))
+	close(release)
+	assert.Nil(<-done)
+
+	first := string(job.LogBuffer("first").Bytes())
+	assert.Contains(first, "first started")
+	assert.Contains(first, "first finished")
+	assert.NotContains(first, "second", "overlapping runs should not write to each other's logs")
+	second := string(job.LogBuffer("second").Bytes())
+	assert.Contains(second, "second finished")
+	assert.NotContains(second, "first")
+
+	assert.Nil(execute("third"))
+	assert.Nil(job.LogBuffer("second"), "only the last finished invocation's logs should be retained")
+	assert.NotNil(job.LogBuffer("first"))
+	assert.NotNil(job.LogBuffer("third"))
+}
+
+func TestJobTags(t *testing.T) {
+	assert := assert.New(t)
+
+	job := NewJob(func(ctx context.Context) error {
+		return nil
+	}).WithConfig(&JobConfig{
+		Tags: map[string]string{"owner": "platform", "team": "infra"},
+	})
+	assert.Equal(map[string]string{"owner": "platform", "team": "infra"}, job.Tags())
+
+	job.WithTags(map[string]string{"team": "jobs", "cost-center": "1234"})
+	assert.Equal(map[string]string{"owner": "platform", "team": "jobs", "cost-center": "1234"}, job.Tags())
+}
+
+func TestJobNotifyStatsTags(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx := cron.WithJobInvocation(context.Background(), &cron.JobInvocation{
+		ID:   uuid.V4().String(),
+		Name: "test-job",
+	})
+
+	collector := &stats.MockCollector{Events: make(chan stats.MockMetric, 2)}
+	job := NewJob(func(ctx context.Context) error {
+		return nil
+	}).WithName("test-job").WithTags(map[string]string{"team": "infra", "owner": "platform"}).WithStatsClient(collector)
+	job.OnStart(ctx)
+	assert.Empty(collector.Events)
+
+	job.WithConfig(&JobConfig{NotifyOnStart: OptBool(true)})
+	job.OnStart(ctx)
+	assert.Len(collector.Events, 2)
+
+	expected := []string{"job:test-job", "owner:platform", "team:infra"}
+	metric := <-collector.Events
+	assert.Equal(string(cron.FlagStarted), metric.Name)
+	assert.Equal(expected, metric.Tags)
+	metric = <-collector.Events
+	assert.Equal(expected, metric.Tags)
+}
+
 func TestJobLifecycleHooksNotificationsUnset(t *testing.T) {
 	assert := assert.New(t)
 
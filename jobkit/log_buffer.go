@@ -0,0 +1,146 @@
+package jobkit
+
+import (
+	"context"
+	"sync"
+)
+
+// LogBufferProvider is a job that captures the log output of its invocations.
+type LogBufferProvider interface {
+	LogBuffer(invocationID string) *LogBuffer
+}
+
+// NewLogBuffer returns a new log buffer that retains at most `maxBytes` of the most recent output.
+func NewLogBuffer(maxBytes int) *LogBuffer {
+	return &LogBuffer{
+		maxBytes: maxBytes,
+	}
+}
+
+// LogBuffer is a capped buffer of log output; once full, the oldest bytes are discarded.
+type LogBuffer struct {
+	sync.Mutex
+	maxBytes int
+	data     []byte
+}
+
+// MaxBytes returns the maximum number of bytes the buffer retains.
+func (lb *LogBuffer) MaxBytes() int {
+	return lb.maxBytes
+}
+
+// Write appends the contents of `p` to the buffer, discarding the oldest bytes if the buffer is full.
+func (lb *LogBuffer) Write(p []byte) (int, error) {
+	lb.Lock()
+	defer lb.Unlock()
+
+	lb.data = append(lb.data, p...)
+	if lb.maxBytes > 0 && len(lb.data) > lb.maxBytes {
+		lb.data = append([]byte(nil), lb.data[len(lb.data)-lb.maxBytes:]...)
+	}
+	return len(p), nil
+}
+
+// Len returns the number of bytes in the buffer.
+func (lb *LogBuffer) Len() int {
+	lb.Lock()
+	defer lb.Unlock()
+	return len(lb.data)
+}
+
+// Bytes returns a copy of the buffer contents.
+func (lb *LogBuffer) Bytes() []byte {
+	return lb.Tail(0)
+}
+
+// Tail returns a copy of the last `n` bytes of the buffer contents.
+// If `n` is not positive or exceeds the buffer length, the full contents are returned.
+func (lb *LogBuffer) Tail(n int) []byte {
+	lb.Lock()
+	defer lb.Unlock()
+
+	data := lb.data
+	if n > 0 && n < len(data) {
+		data = data[len(data)-n:]
+	}
+	return append([]byte(nil), data...)
+}
+
+// Reset clears the buffer.
+func (lb *LogBuffer) Reset() {
+	lb.Lock()
+	defer lb.Unlock()
+	lb.data = nil
+}
+
+// newLogBuffers returns a new set of log buffers that each retain at most `maxBytes`.
+func newLogBuffers(maxBytes int) *logBuffers {
+	return &logBuffers{
+		maxBytes: maxBytes,
+		buffers:  map[string]*LogBuffer{},
+		running:  map[string]bool{},
+	}
+}
+
+// logBuffers holds the log buffers of a job's invocations by invocation id.
+// It retains the buffers of running invocations and of the last finished invocation.
+type logBuffers struct {
+	sync.Mutex
+	maxBytes     int
+	buffers      map[string]*LogBuffer
+	running      map[string]bool
+	lastFinished string
+}
+
+// start returns a new log buffer for an invocation.
+// The buffers of finished invocations, other than the last, are discarded.
+func (lbs *logBuffers) start(invocationID string) *LogBuffer {
+	lbs.Lock()
+	defer lbs.Unlock()
+
+	for id := range lbs.buffers {
+		if !lbs.running[id] && id != lbs.lastFinished {
+			delete(lbs.buffers, id)
+		}
+	}
+	lb := NewLogBuffer(lbs.maxBytes)
+	lbs.buffers[invocationID] = lb
+	lbs.running[invocationID] = true
+	return lb
+}
+
+// finish marks an invocation as the last finished invocation.
+func (lbs *logBuffers) finish(invocationID string) {
+	lbs.Lock()
+	defer lbs.Unlock()
+
+	delete(lbs.running, invocationID)
+	lbs.lastFinished = invocationID
+}
+
+// get returns the log buffer for an invocation, or nil if it is not retained.
+func (lbs *logBuffers) get(invocationID string) *LogBuffer {
+	lbs.Lock()
+	defer lbs.Unlock()
+	return lbs.buffers[invocationID]
+}
+
+type logBufferKey struct{}
+
+// WithLogBuffer adds a log buffer to a context.
+func WithLogBuffer(ctx context.Context, lb *LogBuffer) context.Context {
+	return context.WithValue(ctx, logBufferKey{}, lb)
+}
+
+// GetLogBuffer gets a log buffer from a context.
+func GetLogBuffer(ctx context.Context) *LogBuffer {
+	if ctx == nil {
+		return nil
+	}
+	if value := ctx.Value(logBufferKey{}); value != nil {
+		if typed, ok := value.(*LogBuffer); ok {
+			return typed
+		}
+	}
+	return nil
+}
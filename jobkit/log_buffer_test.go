@@ -0,0 +1,39 @@
+package jobkit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestLogBuffer(t *testing.T) {
+	assert := assert.New(t)
+
+	lb := NewLogBuffer(8)
+	assert.Equal(8, lb.MaxBytes())
+	assert.Empty(lb.Bytes())
+
+	fmt.Fprint(lb, "abcd")
+	assert.Equal("abcd", string(lb.Bytes()))
+
+	fmt.Fprint(lb, "efghij")
+	assert.Equal(8, lb.Len())
+	assert.Equal("cdefghij", string(lb.Bytes()), "the oldest bytes should be discarded")
+	assert.Equal("hij", string(lb.Tail(3)))
+	assert.Equal("cdefghij", string(lb.Tail(100)))
+
+	lb.Reset()
+	assert.Zero(lb.Len())
+}
+
+func TestLogBufferContext(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(GetLogBuffer(nil))
+	assert.Nil(GetLogBuffer(context.Background()))
+
+	lb := NewLogBuffer(DefaultMaxLogBytes)
+	assert.Equal(lb, GetLogBuffer(WithLogBuffer(context.Background(), lb)))
+}
@@ -2,6 +2,8 @@ package jobkit
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/blend/go-sdk/cron"
 	"github.com/blend/go-sdk/logger"
@@ -9,6 +11,7 @@ import (
 
 // Debugf prints an info message if the logger is set.
 func Debugf(ctx context.Context, log logger.Log, format string, args ...interface{}) {
+	captureLog(ctx, logger.Debug, fmt.Sprintf(format, args...))
 	if log == nil {
 		return
 	}
@@ -18,6 +21,7 @@ func Debugf(ctx context.Context, log logger.Log, format string, args ...interfac
 
 // Infof prints an info message if the logger is set.
 func Infof(ctx context.Context, log logger.Log, format string, args ...interface{}) {
+	captureLog(ctx, logger.Info, fmt.Sprintf(format, args...))
 	if log == nil {
 		return
 	}
@@ -27,6 +31,7 @@ func Infof(ctx context.Context, log logger.Log, format string, args ...interface
 
 // Warningf prints a warning message if the logger is set.
 func Warningf(ctx context.Context, log logger.Log, format string, args ...interface{}) {
+	captureLog(ctx, logger.Warning, fmt.Sprintf(format, args...))
 	if log == nil {
 		return
 	}
@@ -36,6 +41,7 @@ func Warningf(ctx context.Context, log logger.Log, format string, args ...interf
 
 // Warning prints an warning if the logger is set.
 func Warning(ctx context.Context, log logger.Log, err error) {
+	captureLog(ctx, logger.Warning, err.Error())
 	if log == nil {
 		return
 	}
@@ -45,6 +51,7 @@ func Warning(ctx context.Context, log logger.Log, err error) {
 
 // Errorf prints an error message if the logger is set.
 func Errorf(ctx context.Context, log logger.Log, format string, args ...interface{}) {
+	captureLog(ctx, logger.Error, fmt.Sprintf(format, args...))
 	if log == nil {
 		return
 	}
@@ -54,6 +61,7 @@ func Errorf(ctx context.Context, log logger.Log, format string, args ...interfac
 
 // Error prints an error if the logger is set.
 func Error(ctx context.Context, log logger.Log, err error) {
+	captureLog(ctx, logger.Error, err.Error())
 	if log == nil {
 		return
 	}
@@ -63,6 +71,7 @@ func Error(ctx context.Context, log logger.Log, err error) {
 
 // Fatalf prints a fatal error message if the logger is set.
 func Fatalf(ctx context.Context, log logger.Log, format string, args ...interface{}) {
+	captureLog(ctx, logger.Fatal, fmt.Sprintf(format, args...))
 	if log == nil {
 		return
 	}
@@ -72,9 +81,17 @@ func Fatalf(ctx context.Context, log logger.Log, format string, args ...interfac
 
 // Fatal prints a fatal error if the logger is set.
 func Fatal(ctx context.Context, log logger.Log, err error) {
+	captureLog(ctx, logger.Fatal, err.Error())
 	if log == nil {
 		return
 	}
 	ji := cron.GetJobInvocation(ctx)
 	log.SubContext(ji.ID).Fatal(err)
 }
+
+// captureLog writes a message to the log buffer of the job invocation, if one is set on the context.
+func captureLog(ctx context.Context, flag logger.Flag, message string) {
+	if lb := GetLogBuffer(ctx); lb != nil {
+		fmt.Fprintf(lb, "%s [%s] %s\n", time.Now().UTC().Format(time.RFC3339), flag, message)
+	}
+}
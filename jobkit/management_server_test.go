@@ -1,9 +1,18 @@
 package jobkit
 
 import (
+	"bufio"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/blend/go-sdk/assert"
 	"github.com/blend/go-sdk/cron"
@@ -15,7 +24,7 @@ func TestManagementServer(t *testing.T) {
 
 	jm := cron.New()
 
-	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }).WithTags(map[string]string{"owner": "platform"}))
 	jm.LoadJob(cron.NewJob("test1", func(_ context.Context) error { return nil }))
 
 	app := NewManagementServer(jm, &Config{
@@ -33,6 +42,67 @@ func TestManagementServer(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal(http.StatusOK, meta.StatusCode)
 	assert.Len(jobs.Jobs, 2)
+	for _, js := range jobs.Jobs {
+		if js.Name == "test0" {
+			assert.Equal("platform", js.Tags["owner"])
+		} else {
+			assert.Empty(js.Tags)
+		}
+	}
+}
+
+func TestManagementServerIndexStatusFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	var runs int
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error {
+		runs++
+		if runs%2 == 0 {
+			return fmt.Errorf("this is only a test")
+		}
+		return nil
+	}))
+
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+	for x := 0; x < 5; x++ {
+		js.Run()
+	}
+
+	viewModel := indexViewModel(jm, "")
+	assert.Len(viewModel.Jobs, 1)
+	assert.Len(viewModel.Jobs[0].History, 5)
+
+	viewModel = indexViewModel(jm, cron.JobStatusFailed)
+	assert.Equal(cron.JobStatusFailed, viewModel.Status)
+	assert.Len(viewModel.Jobs[0].History, 2)
+	for _, ji := range viewModel.Jobs[0].History {
+		assert.Equal(cron.JobStatusFailed, ji.Status)
+	}
+
+	app := NewManagementServer(jm, &Config{
+		Web: web.Config{
+			Port: 5000,
+		},
+	})
+
+	contents, meta, err := app.Mock().Get("/").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(3, strings.Count(string(contents), `<tr class="ok">`))
+	assert.Equal(2, strings.Count(string(contents), `<tr class="failed">`))
+
+	contents, meta, err = app.Mock().Get("/").WithQueryString("status", "failed").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Zero(strings.Count(string(contents), `<tr class="ok">`))
+	assert.Equal(2, strings.Count(string(contents), `<tr class="failed">`))
+
+	contents, meta, err = app.Mock().Get("/").WithQueryString("status", "cancelled").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Contains(string(contents), "No cancelled History")
 }
 
 func TestManagementServerHealthz(t *testing.T) {
@@ -59,3 +129,526 @@ func TestManagementServerHealthz(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal(http.StatusInternalServerError, meta.StatusCode)
 }
+
+func TestManagementServerReadyz(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }).WithSchedule(cron.Every(time.Minute)))
+	jm.LoadJob(cron.NewJob("test1", func(_ context.Context) error { return nil }))
+
+	app := NewManagementServer(jm, &Config{
+		Web: web.Config{
+			Port: 5000,
+		},
+	})
+
+	meta, err := app.Mock().Get("/readyz").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusServiceUnavailable, meta.StatusCode)
+
+	jm.Start()
+	defer jm.Stop()
+
+	meta, err = app.Mock().Get("/readyz").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+
+	jm.Pause()
+
+	meta, err = app.Mock().Get("/readyz").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusServiceUnavailable, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/livez").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+
+	var healthz string
+	meta, err = app.Mock().Get("/healthz").JSONWithMeta(&healthz)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(HealthzPaused, healthz, "healthz should report the paused state")
+
+	jm.Resume()
+
+	meta, err = app.Mock().Get("/healthz").JSONWithMeta(&healthz)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal("OK!", healthz)
+
+	meta, err = app.Mock().Get("/readyz").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/livez").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+}
+
+func TestManagementServerJobHistory(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+	for x := 0; x < 5; x++ {
+		js.Run()
+	}
+	assert.Len(js.History, 5)
+
+	app := NewManagementServer(jm, &Config{
+		Web: web.Config{
+			Port: 5000,
+		},
+	})
+
+	var history JobHistory
+	meta, err := app.Mock().Get("/api/job.history/test0").JSONWithMeta(&history)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal("test0", history.Name)
+	assert.Equal(5, history.Total)
+	assert.Equal(DefaultHistoryLimit, history.Limit)
+	assert.Len(history.History, 5)
+	assert.Equal(js.History[4].ID, history.History[0].ID, "the newest invocation should be first")
+	assert.Equal(js.History[0].ID, history.History[4].ID)
+
+	meta, err = app.Mock().Get("/api/job.history/test0").WithQueryString("limit", "2").WithQueryString("offset", "1").JSONWithMeta(&history)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(5, history.Total)
+	assert.Equal(2, history.Limit)
+	assert.Equal(1, history.Offset)
+	assert.Len(history.History, 2)
+	assert.Equal(js.History[3].ID, history.History[0].ID)
+	assert.Equal(js.History[2].ID, history.History[1].ID)
+
+	meta, err = app.Mock().Get("/api/job.history/test0").WithQueryString("offset", "10").JSONWithMeta(&history)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Empty(history.History)
+
+	meta, err = app.Mock().Get("/api/job.history/test0").WithQueryString("limit", "foo").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/api/job.history/test0").WithQueryString("limit", "0").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/api/job.history/test0").WithQueryString("offset", "-1").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/api/job.history/not-a-job").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusNotFound, meta.StatusCode)
+}
+
+func TestManagementServerJobStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	var runs int32
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	}))
+
+	app := NewManagementServer(jm, &Config{
+		Web: web.Config{
+			Port: 5000,
+		},
+	})
+
+	var status cron.JobScheduler
+	meta, err := app.Mock().Get("/api/job.status/test0").JSONWithMeta(&status)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal("test0", status.Name)
+
+	time.Sleep(10 * time.Millisecond)
+	assert.Zero(atomic.LoadInt32(&runs), "querying the status should not run the job")
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+	assert.Empty(js.History)
+
+	meta, err = app.Mock().Get("/api/job.status/not-a-job").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusNotFound, meta.StatusCode)
+}
+
+func TestManagementServerJobLogs(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(NewJob(func(ctx context.Context) error {
+		for x := 0; x < 10; x++ {
+			Infof(ctx, nil, "line %d", x)
+		}
+		return nil
+	}).WithName("test0"))
+	jm.LoadJob(cron.NewJob("test1", func(_ context.Context) error { return nil }))
+
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+	js.Run()
+
+	app := NewManagementServer(jm, &Config{
+		Web: web.Config{
+			Port: 5000,
+		},
+		MaxLogBytes: 40,
+	})
+
+	contents, meta, err := app.Mock().Get("/api/job.logs/test0").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(web.ContentTypeText, meta.Headers.Get(web.HeaderContentType))
+	assert.Len(contents, 40, "the logs should be capped at the max log bytes")
+	assert.True(strings.HasSuffix(string(contents), "[info] line 9\n"))
+
+	contents, meta, err = app.Mock().Get("/api/job.logs/test0").WithQueryString("tail", "7").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal("line 9\n", string(contents))
+
+	meta, err = app.Mock().Get("/api/job.logs/test0").WithQueryString("tail", "foo").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/api/job.logs/test0").WithQueryString("tail", "-1").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/api/job.logs/test1").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusNotFound, meta.StatusCode, "jobs that do not capture logs should not be found")
+
+	meta, err = app.Mock().Get("/api/job.logs/not-a-job").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusNotFound, meta.StatusCode)
+}
+
+func TestManagementServerRunAndCancelAll(t *testing.T) {
+	assert := assert.New(t)
+
+	ran := make(chan struct{}, 1)
+	started := make(chan struct{})
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error {
+		ran <- struct{}{}
+		return nil
+	}))
+	jm.LoadJob(cron.NewJob("test1", func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return nil
+	}))
+
+	assert.Nil(jm.RunJob("test1"))
+	<-started
+
+	app := NewManagementServer(jm, &Config{
+		Web: web.Config{
+			Port: 5000,
+		},
+	})
+
+	var results map[string]JobResult
+	meta, err := app.Mock().Post("/api/jobs.run").JSONWithMeta(&results)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Len(results, 2)
+	assert.True(results["test0"].OK)
+	assert.Empty(results["test0"].Err)
+	assert.False(results["test1"].OK)
+	assert.Equal("job is already running", results["test1"].Err)
+
+	<-ran
+	for jm.IsJobRunning("test0") {
+		time.Sleep(time.Millisecond)
+	}
+
+	meta, err = app.Mock().Post("/api/jobs.cancel").JSONWithMeta(&results)
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Len(results, 2)
+	assert.False(results["test0"].OK)
+	assert.Equal("job is not running", results["test0"].Err)
+	assert.True(results["test1"].OK)
+
+	for jm.IsJobRunning("test1") {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestManagementServerMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+	jm.LoadJob(cron.NewJob("test1", func(_ context.Context) error { return fmt.Errorf("this is only a test") }))
+
+	_, err := NewManagementServer(jm, &Config{}).Mock().Get("/metrics").ExecuteWithMeta()
+	assert.NotNil(err, "the endpoint should not be registered by default")
+
+	app := NewManagementServer(jm, &Config{
+		EnablePrometheus: true,
+	})
+	for _, name := range []string{"test0", "test0", "test1"} {
+		js, err := jm.Job(name)
+		assert.Nil(err)
+		js.Run()
+	}
+	contents, meta, err := app.Mock().Get("/metrics").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.Equal(ContentTypePrometheus, meta.Headers.Get("Content-Type"))
+
+	metrics := string(contents)
+	assert.Contains(metrics, "# TYPE cron_job_successes_total counter")
+	assert.Contains(metrics, `cron_job_successes_total{job="test0"} 2`)
+	assert.Contains(metrics, `cron_job_successes_total{job="test1"} 0`)
+	assert.Contains(metrics, `cron_job_failures_total{job="test0"} 0`)
+	assert.Contains(metrics, `cron_job_failures_total{job="test1"} 1`)
+	assert.Contains(metrics, `cron_job_running{job="test0"} 0`)
+	assert.Contains(metrics, "# TYPE cron_job_elapsed_seconds histogram")
+	assert.Contains(metrics, `cron_job_elapsed_seconds_bucket{job="test0",le="+Inf"} 2`)
+	assert.Contains(metrics, `cron_job_elapsed_seconds_count{job="test1"} 1`)
+}
+
+func TestManagementServerMetricsCumulative(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.NewFromConfig(&cron.Config{
+		History: cron.HistoryConfig{
+			MaxCount: 1,
+		},
+	})
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+	app := NewManagementServer(jm, &Config{
+		EnablePrometheus: true,
+	})
+
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+	for x := 0; x < 3; x++ {
+		js.Run()
+	}
+	assert.True(len(js.History) < 3, "the history should have been culled")
+
+	contents, err := app.Mock().Get("/metrics").Bytes()
+	assert.Nil(err)
+	metrics := string(contents)
+	assert.Contains(metrics, `cron_job_successes_total{job="test0"} 3`, "counters should not go down as history is culled")
+	assert.Contains(metrics, `cron_job_elapsed_seconds_count{job="test0"} 3`)
+}
+
+func TestManagementServerMetricsSharedManager(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+	first := NewManagementServer(jm, &Config{
+		EnablePrometheus: true,
+	})
+	second := NewManagementServer(jm, &Config{
+		EnablePrometheus: true,
+	})
+
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+	js.Run()
+
+	elapsed := prometheusElapsedFor(jm)
+	assert.ReferenceEqual(elapsed, prometheusElapsedFor(jm))
+	assert.Equal(1, elapsed.jobs["test0"].Count, "the hook should only be added once per manager")
+
+	for _, app := range []*web.App{first, second} {
+		contents, err := app.Mock().Get("/metrics").Bytes()
+		assert.Nil(err)
+		assert.Contains(string(contents), `cron_job_elapsed_seconds_count{job="test0"} 1`, "each run should be observed once per manager")
+	}
+}
+
+func TestPrometheusJobMetricsRunning(t *testing.T) {
+	assert := assert.New(t)
+
+	js := cron.NewJobScheduler(&cron.Config{}, cron.NewJob("test0", func(_ context.Context) error { return nil }))
+	js.ActiveRuns = 3
+	assert.Equal(3, newPrometheusJobMetrics(js).Running)
+}
+
+func TestPrometheusLabelValue(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(`"test"`, prometheusLabelValue("test"))
+	assert.Equal(`"a \"quoted\\path\"\nnext"`, prometheusLabelValue("a \"quoted\\path\"\nnext"))
+}
+
+func TestManagementServerStream(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+
+	server := httptest.NewServer(NewManagementServer(jm, &Config{}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/stream", nil)
+	assert.Nil(err)
+	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	assert.Nil(err)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode)
+	assert.Equal(ContentTypeEventStream, res.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(res.Body)
+	readStatus := func() cron.Status {
+		line, err := reader.ReadString('\n')
+		assert.Nil(err)
+		assert.True(strings.HasPrefix(line, "data: "))
+		blank, err := reader.ReadString('\n')
+		assert.Nil(err)
+		assert.Equal("\n", blank)
+
+		var status cron.Status
+		assert.Nil(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &status))
+		return status
+	}
+
+	status := readStatus()
+	assert.Len(status.Jobs, 1)
+	assert.Nil(status.Jobs[0].Last)
+
+	js.Run()
+	readStatus() // started
+	status = readStatus()
+	assert.Len(status.Jobs, 1)
+	assert.NotNil(status.Jobs[0].Last, "the status should include the finished invocation")
+
+	assert.Nil(jm.DisableJob("test0"))
+	status = readStatus()
+	assert.True(status.Jobs[0].Disabled)
+}
+
+func TestManagementServerGZip(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	for index := 0; index < 32; index++ {
+		jm.LoadJob(cron.NewJob(fmt.Sprintf("test%d", index), func(_ context.Context) error { return nil }))
+	}
+
+	server := httptest.NewServer(NewManagementServer(jm, &Config{}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/jobs", nil)
+	assert.Nil(err)
+	req.Header.Set("Accept-Encoding", "gzip")
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(err)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode)
+	assert.Equal("gzip", res.Header.Get("Content-Encoding"))
+
+	gzr, err := gzip.NewReader(res.Body)
+	assert.Nil(err)
+	contents, err := ioutil.ReadAll(gzr)
+	assert.Nil(err)
+	var status cron.Status
+	assert.Nil(json.Unmarshal(contents, &status))
+	assert.Len(status.Jobs, 32)
+}
+
+func TestManagementServerState(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+	app := NewManagementServer(jm, &Config{})
+
+	state, meta, err := app.Mock().Get("/api/state").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+
+	assert.Nil(jm.DisableJob("test0"))
+	assert.True(jm.IsJobDisabled("test0"))
+
+	meta, err = app.Mock().Put("/api/state").WithPostBody(state).ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.False(jm.IsJobDisabled("test0"))
+
+	meta, err = app.Mock().Put("/api/state").WithPostBody([]byte(`{"jobs":[{"name":"not-loaded"}]}`)).ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusBadRequest, meta.StatusCode)
+}
+
+func TestManagementServerAuth(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+
+	app := NewManagementServer(jm, &Config{
+		ManagementBearerToken: "a-token",
+		ManagementUsername:    "admin",
+		ManagementPassword:    "a-password",
+	})
+
+	var message string
+	meta, err := app.Mock().Post("/api/job.disable/test0").JSONWithMeta(&message)
+	assert.Nil(err)
+	assert.Equal(http.StatusUnauthorized, meta.StatusCode)
+	assert.Equal("Not Authorized", message)
+	assert.NotEmpty(meta.Headers.Get("WWW-Authenticate"))
+
+	meta, err = app.Mock().Post("/api/job.disable/test0").WithHeader("Authorization", "Bearer not-the-token").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusUnauthorized, meta.StatusCode)
+
+	meta, err = app.Mock().Post("/api/job.disable/test0").WithBasicAuth("admin", "not-the-password").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusUnauthorized, meta.StatusCode)
+
+	meta, err = app.Mock().Post("/api/jobs.run").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusUnauthorized, meta.StatusCode)
+
+	js, err := jm.Job("test0")
+	assert.Nil(err)
+	assert.False(js.Disabled, "unauthorized requests should not have changed the job")
+
+	meta, err = app.Mock().Post("/api/job.disable/test0").WithHeader("Authorization", "Bearer a-token").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+	assert.True(js.Disabled)
+
+	meta, err = app.Mock().Post("/api/job.cancel/test0").WithBasicAuth("admin", "a-password").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+
+	meta, err = app.Mock().Get("/api/jobs").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode, "read only routes should not require credentials")
+}
+
+func TestManagementServerAuthUnset(t *testing.T) {
+	assert := assert.New(t)
+
+	jm := cron.New()
+	jm.LoadJob(cron.NewJob("test0", func(_ context.Context) error { return nil }))
+
+	meta, err := NewManagementServer(jm, &Config{}).Mock().Post("/api/job.disable/test0").ExecuteWithMeta()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, meta.StatusCode)
+}
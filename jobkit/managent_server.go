@@ -1,7 +1,11 @@
 package jobkit
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
 
 	"github.com/blend/go-sdk/cron"
 	"github.com/blend/go-sdk/web"
@@ -9,32 +13,152 @@ import (
 
 // NewManagementServer returns a new management server that lets you
 // trigger jobs or look at job statuses via. a json api.
+// If management credentials are set on the config, they are required by the api routes that change jobs.
 func NewManagementServer(jm *cron.JobManager, cfg *Config) *web.App {
 	app := web.NewFromConfig(&cfg.Web)
+	app.WithDefaultMiddleware(web.GZip(web.DefaultGZipMinSize))
 	app.Views().AddLiterals(headerTemplate, footerTemplate, indexTemplate)
 	app.GET("/", func(r *web.Ctx) web.Result {
-		return r.View().View("index", jm.Status())
+		status, _ := r.QueryValue("status")
+		return r.View().View("index", indexViewModel(jm, cron.JobStatus(status)))
 	})
-	app.GET("/healthz", func(_ *web.Ctx) web.Result {
+	livez := func(_ *web.Ctx) web.Result {
 		if jm.IsRunning() {
 			return web.JSON.OK()
 		}
 		return web.JSON.InternalError(fmt.Errorf("job manager is stopped or in an inconsistent state"))
+	}
+	app.GET("/livez", livez)
+	app.GET("/healthz", func(r *web.Ctx) web.Result {
+		if jm.IsRunning() && jm.IsPaused() {
+			return web.JSON.Result(HealthzPaused)
+		}
+		return livez(r)
 	})
+	app.GET("/readyz", func(_ *web.Ctx) web.Result {
+		if err := readiness(jm); err != nil {
+			return web.JSON.Status(http.StatusServiceUnavailable, err.Error())
+		}
+		return web.JSON.OK()
+	})
+	if cfg.EnablePrometheus {
+		elapsed := prometheusElapsedFor(jm)
+		app.GET("/metrics", func(r *web.Ctx) web.Result {
+			return r.RawWithContentType(ContentTypePrometheus, prometheusMetrics(jm.Status(), elapsed))
+		})
+	}
 	app.GET("/api/jobs", func(_ *web.Ctx) web.Result {
 		return web.JSON.Result(jm.Status())
 	})
+	app.GET("/api/state", func(r *web.Ctx) web.Result {
+		state, err := jm.ExportState()
+		if err != nil {
+			return web.JSON.InternalError(err)
+		}
+		return r.RawWithContentType(web.ContentTypeApplicationJSON, state)
+	})
+	app.PUT("/api/state", func(r *web.Ctx) web.Result {
+		body, err := r.PostBody()
+		if err != nil {
+			return web.JSON.BadRequest(err)
+		}
+		if err := jm.ImportState(body); err != nil {
+			if cron.IsStateInvalid(err) || cron.IsJobNotLoaded(err) {
+				return web.JSON.BadRequest(err)
+			}
+			return web.JSON.InternalError(err)
+		}
+		return web.JSON.OK()
+	}, authorized(cfg))
+	app.GET("/api/stream", func(r *web.Ctx) web.Result {
+		return streamStatus(r, jm)
+	})
 	app.GET("/api/job.status/:jobName", func(r *web.Ctx) web.Result {
 		jobName, err := r.RouteParam("jobName")
 		if err != nil {
 			return web.JSON.BadRequest(err)
 		}
 		status, err := jm.Job(jobName)
-		if err := jm.RunJob(jobName); err != nil {
-			return web.JSON.BadRequest(err)
+		if err != nil {
+			if cron.IsJobNotLoaded(err) {
+				return web.JSON.NotFound()
+			}
+			return web.JSON.InternalError(err)
 		}
 		return web.JSON.Result(status)
 	})
+	app.GET("/api/job.history/:jobName", func(r *web.Ctx) web.Result {
+		jobName, err := r.RouteParam("jobName")
+		if err != nil {
+			return web.JSON.BadRequest(err)
+		}
+		limit, err := queryInt(r, "limit", DefaultHistoryLimit)
+		if err != nil {
+			return web.JSON.BadRequest(err)
+		}
+		if limit <= 0 {
+			return web.JSON.BadRequest(fmt.Errorf("limit must be greater than zero"))
+		}
+		offset, err := queryInt(r, "offset", 0)
+		if err != nil {
+			return web.JSON.BadRequest(err)
+		}
+		if offset < 0 {
+			return web.JSON.BadRequest(fmt.Errorf("offset must not be negative"))
+		}
+		js, err := jm.Job(jobName)
+		if err != nil {
+			if cron.IsJobNotLoaded(err) {
+				return web.JSON.NotFound()
+			}
+			return web.JSON.InternalError(err)
+		}
+		return web.JSON.Result(jobHistory(js, limit, offset))
+	})
+	app.GET("/api/job.logs/:jobName", func(r *web.Ctx) web.Result {
+		jobName, err := r.RouteParam("jobName")
+		if err != nil {
+			return web.Text.BadRequest(err)
+		}
+		tail, err := queryInt(r, "tail", 0)
+		if err != nil {
+			return web.Text.BadRequest(err)
+		}
+		if tail < 0 {
+			return web.Text.BadRequest(fmt.Errorf("tail must not be negative"))
+		}
+		js, err := jm.Job(jobName)
+		if err != nil {
+			if cron.IsJobNotLoaded(err) {
+				return web.Text.NotFound()
+			}
+			return web.Text.InternalError(err)
+		}
+		logs, ok := jobLogs(js, cfg.MaxLogBytesOrDefault(), tail)
+		if !ok {
+			return web.Text.NotFound()
+		}
+		return r.RawWithContentType(web.ContentTypeText, logs)
+	})
+	app.POST("/api/jobs.run", func(_ *web.Ctx) web.Result {
+		return web.JSON.Result(forEachJob(jm, func(jobName string) error {
+			if jm.IsJobDisabled(jobName) {
+				return fmt.Errorf("job is disabled")
+			}
+			if jm.IsJobRunning(jobName) {
+				return fmt.Errorf("job is already running")
+			}
+			return jm.RunJob(jobName)
+		}))
+	}, authorized(cfg))
+	app.POST("/api/jobs.cancel", func(_ *web.Ctx) web.Result {
+		return web.JSON.Result(forEachJob(jm, func(jobName string) error {
+			if !jm.IsJobRunning(jobName) {
+				return fmt.Errorf("job is not running")
+			}
+			return jm.CancelJob(jobName)
+		}))
+	}, authorized(cfg))
 	app.POST("/api/job.run/:jobName", func(r *web.Ctx) web.Result {
 		jobName, err := r.RouteParam("jobName")
 		if err != nil {
@@ -44,7 +168,7 @@ func NewManagementServer(jm *cron.JobManager, cfg *Config) *web.App {
 			return web.JSON.BadRequest(err)
 		}
 		return web.JSON.OK()
-	})
+	}, authorized(cfg))
 	app.POST("/api/job.cancel/:jobName", func(r *web.Ctx) web.Result {
 		jobName, err := r.RouteParam("jobName")
 		if err != nil {
@@ -54,7 +178,7 @@ func NewManagementServer(jm *cron.JobManager, cfg *Config) *web.App {
 			return web.JSON.BadRequest(err)
 		}
 		return web.JSON.OK()
-	})
+	}, authorized(cfg))
 	app.POST("/api/job.disable/:jobName", func(r *web.Ctx) web.Result {
 		jobName, err := r.RouteParam("jobName")
 		if err != nil {
@@ -64,7 +188,7 @@ func NewManagementServer(jm *cron.JobManager, cfg *Config) *web.App {
 			return web.JSON.BadRequest(err)
 		}
 		return web.JSON.Result(fmt.Sprintf("%s disabled", jobName))
-	})
+	}, authorized(cfg))
 	app.POST("/api/job.enable/:jobName", func(r *web.Ctx) web.Result {
 		jobName, err := r.RouteParam("jobName")
 		if err != nil {
@@ -74,6 +198,181 @@ func NewManagementServer(jm *cron.JobManager, cfg *Config) *web.App {
 			return web.JSON.BadRequest(err)
 		}
 		return web.JSON.Result(fmt.Sprintf("%s enabled", jobName))
-	})
+	}, authorized(cfg))
 	return app
 }
+
+// streamStatus writes the job manager status as server-sent events, once when the client connects
+// and again each time a job's state changes, until the client disconnects.
+func streamStatus(r *web.Ctx, jm *cron.JobManager) web.Result {
+	if _, ok := r.Response().InnerResponse().(http.Flusher); !ok {
+		return web.JSON.InternalError(fmt.Errorf("the response does not support streaming"))
+	}
+
+	changes, unsubscribe := jm.Subscribe(DefaultStreamBufferSize)
+	defer unsubscribe()
+
+	r.Response().Header().Set(web.HeaderContentType, ContentTypeEventStream)
+	r.Response().Header().Set("Cache-Control", "no-cache")
+	r.Response().Header().Set("Connection", "keep-alive")
+	r.Response().WriteHeader(http.StatusOK)
+
+	if err := writeStatusEvent(r.Response(), statusSnapshot(jm)); err != nil {
+		return nil
+	}
+	flushResponse(r.Response())
+	for {
+		select {
+		case <-r.Context().Done():
+			return nil
+		case _, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if err := writeStatusEvent(r.Response(), statusSnapshot(jm)); err != nil {
+				return nil
+			}
+			flushResponse(r.Response())
+		}
+	}
+}
+
+// flushResponse flushes a response, including its compressed stream if it is compressed.
+func flushResponse(rw web.ResponseWriter) {
+	if typed, ok := rw.(interface{ Flush() error }); ok {
+		typed.Flush()
+	}
+	if typed, ok := rw.InnerResponse().(http.Flusher); ok {
+		typed.Flush()
+	}
+}
+
+// statusSnapshot returns a copy of the job manager status that is safe to encode while jobs are running.
+func statusSnapshot(jm *cron.JobManager) *cron.Status {
+	snapshot := cron.Status{
+		Running: map[string][]*cron.JobInvocation{},
+	}
+	for _, js := range jm.Status().Jobs {
+		job := js.Snapshot()
+		snapshot.Jobs = append(snapshot.Jobs, job)
+		if active := job.ActiveInvocations(); len(active) > 0 {
+			snapshot.Running[job.Name] = active
+		}
+	}
+	return &snapshot
+}
+
+// writeStatusEvent writes a server-sent event `data:` frame with the json status.
+func writeStatusEvent(w io.Writer, status *cron.Status) error {
+	contents, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", contents)
+	return err
+}
+
+// JobResult is the result of an action applied to a single job.
+type JobResult struct {
+	OK  bool   `json:"ok"`
+	Err string `json:"err,omitempty"`
+}
+
+// forEachJob applies an action to every loaded job, returning each job's result by job name.
+// An error for one job does not stop the action from being applied to the rest.
+func forEachJob(jm *cron.JobManager, action func(jobName string) error) map[string]JobResult {
+	results := map[string]JobResult{}
+	for _, js := range jm.Status().Jobs {
+		if err := action(js.Name); err != nil {
+			results[js.Name] = JobResult{Err: err.Error()}
+			continue
+		}
+		results[js.Name] = JobResult{OK: true}
+	}
+	return results
+}
+
+// JobHistory is a page of a job's invocation history, newest first.
+type JobHistory struct {
+	Name    string               `json:"name"`
+	Total   int                  `json:"total"`
+	Limit   int                  `json:"limit"`
+	Offset  int                  `json:"offset"`
+	History []cron.JobInvocation `json:"history"`
+}
+
+// jobHistory returns a page of a job's invocation history, newest first.
+func jobHistory(js *cron.JobScheduler, limit, offset int) JobHistory {
+	js.Lock()
+	history := js.History
+	js.Unlock()
+
+	page := []cron.JobInvocation{}
+	for index := len(history) - 1 - offset; index >= 0 && len(page) < limit; index-- {
+		page = append(page, history[index])
+	}
+	return JobHistory{
+		Name:    js.Name,
+		Total:   len(history),
+		Limit:   limit,
+		Offset:  offset,
+		History: page,
+	}
+}
+
+// jobLogs returns the captured log output of a job's last invocation, or of its current invocation
+// if no invocation has finished, limited to the last `maxBytes` and, if `tail` is positive, to the last `tail` bytes.
+// It returns false if the job does not capture logs or the invocation's logs are not retained.
+func jobLogs(js *cron.JobScheduler, maxBytes, tail int) ([]byte, bool) {
+	typed, ok := js.Job.(LogBufferProvider)
+	if !ok {
+		return nil, false
+	}
+	snapshot := js.Snapshot()
+	ji := snapshot.Last
+	if ji == nil {
+		ji = snapshot.Current
+	}
+	if ji == nil {
+		return nil, false
+	}
+	lb := typed.LogBuffer(ji.ID)
+	if lb == nil {
+		return nil, false
+	}
+	if tail <= 0 || tail > maxBytes {
+		tail = maxBytes
+	}
+	return lb.Tail(tail), true
+}
+
+// queryInt returns an integer query string value, or a default if it is unset.
+func queryInt(r *web.Ctx, key string, defaultValue int) (int, error) {
+	value, err := r.QueryValue(key)
+	if err != nil {
+		return defaultValue, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", key)
+	}
+	return parsed, nil
+}
+
+// readiness returns an error if the job manager is not ready to run jobs, i.e.
+// if it is not running, it is paused, or a job's initial schedule has not been computed.
+func readiness(jm *cron.JobManager) error {
+	if !jm.IsRunning() {
+		return fmt.Errorf("job manager is not running")
+	}
+	if jm.IsPaused() {
+		return fmt.Errorf("job manager is paused")
+	}
+	for _, js := range jm.Status().Jobs {
+		// the latch is safe to read directly, but the next runtime is written by the run loop.
+		if js.Schedule != nil && js.Latch.IsRunning() && js.Snapshot().NextRuntime.IsZero() {
+			return fmt.Errorf("job %s has not computed its schedule", js.Name)
+		}
+	}
+	return nil
+}
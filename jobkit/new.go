@@ -16,10 +16,14 @@ import (
 
 // New returns a new job.
 func New(jobConfig *JobConfig, cfg *Config, action func(context.Context) error) (*Job, error) {
-	schedule, err := cron.ParseString(jobConfig.ScheduleOrDefault())
+	parsed, err := cron.ParseString(jobConfig.ScheduleOrDefault())
 	if err != nil {
 		return nil, err
 	}
+	var schedule cron.Schedule = parsed
+	if jitter := jobConfig.JitterOrDefault(); jitter > 0 {
+		schedule = cron.WithJitter(schedule, jitter)
+	}
 
 	// set up myriad of notification targets
 	var emailClient email.Sender
@@ -47,6 +51,7 @@ func New(jobConfig *JobConfig, cfg *Config, action func(context.Context) error)
 		WithConfig(jobConfig).
 		WithSchedule(schedule).
 		WithTimeout(jobConfig.TimeoutOrDefault()).
+		WithMaxLogBytes(cfg.MaxLogBytesOrDefault()).
 		WithEmailClient(emailClient).
 		WithStatsClient(statsClient).
 		WithSlackClient(slackClient).
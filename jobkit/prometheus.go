@@ -0,0 +1,171 @@
+package jobkit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/cron"
+)
+
+// PrometheusElapsedBuckets are the upper bounds, in seconds, of the job elapsed time histogram buckets.
+var PrometheusElapsedBuckets = []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 600, 1800, 3600}
+
+// prometheusJobMetrics are the metrics for a single job.
+type prometheusJobMetrics struct {
+	Name      string
+	Successes int64
+	Failures  int64
+	Running   int
+	Elapsed   prometheusHistogram
+}
+
+// prometheusHistogram is a cumulative histogram of elapsed times.
+type prometheusHistogram struct {
+	Counts []int
+	Count  int
+	Sum    float64
+}
+
+// observe adds an elapsed time to the histogram.
+func (ph *prometheusHistogram) observe(elapsed time.Duration) {
+	if ph.Counts == nil {
+		ph.Counts = make([]int, len(PrometheusElapsedBuckets))
+	}
+	seconds := elapsed.Seconds()
+	ph.Sum += seconds
+	ph.Count++
+	for index, bound := range PrometheusElapsedBuckets {
+		if seconds <= bound {
+			ph.Counts[index]++
+		}
+	}
+}
+
+var (
+	prometheusElapsedLock      sync.Mutex
+	prometheusElapsedByManager = map[*cron.JobManager]*prometheusElapsed{}
+)
+
+// prometheusElapsedFor returns the elapsed time collector for a job manager.
+// The collector's hook is added the first time a manager is seen, so management servers
+// created for the same manager share a collector and each run is only observed once.
+func prometheusElapsedFor(jm *cron.JobManager) *prometheusElapsed {
+	prometheusElapsedLock.Lock()
+	defer prometheusElapsedLock.Unlock()
+
+	if elapsed, ok := prometheusElapsedByManager[jm]; ok {
+		return elapsed
+	}
+	elapsed := newPrometheusElapsed()
+	jm.OnAfterRun(elapsed.Observe)
+	prometheusElapsedByManager[jm] = elapsed
+	return elapsed
+}
+
+// newPrometheusElapsed returns a new elapsed time collector.
+func newPrometheusElapsed() *prometheusElapsed {
+	return &prometheusElapsed{
+		jobs: map[string]prometheusHistogram{},
+	}
+}
+
+// prometheusElapsed collects the elapsed time histograms of finished job invocations as they finish.
+// Unlike the job history, which is culled, the histograms only ever go up.
+type prometheusElapsed struct {
+	sync.Mutex
+	jobs map[string]prometheusHistogram
+}
+
+// Observe is a `cron.AfterRunHook` that adds completed and failed invocations to the job's histogram.
+func (pe *prometheusElapsed) Observe(_ context.Context, jobName string, ji *cron.JobInvocation) {
+	if ji.Status != cron.JobStatusComplete && ji.Status != cron.JobStatusFailed {
+		return
+	}
+	pe.Lock()
+	defer pe.Unlock()
+	histogram := pe.jobs[jobName]
+	histogram.observe(ji.Elapsed)
+	pe.jobs[jobName] = histogram
+}
+
+// Histogram returns a copy of the histogram for a job.
+func (pe *prometheusElapsed) Histogram(jobName string) prometheusHistogram {
+	pe.Lock()
+	defer pe.Unlock()
+	histogram := pe.jobs[jobName]
+	histogram.Counts = append([]int(nil), histogram.Counts...)
+	return histogram
+}
+
+// prometheusMetrics returns the job metrics in the prometheus text exposition format.
+// The counters are the jobs' cumulative totals, and the histograms are from the elapsed time collector.
+func prometheusMetrics(status *cron.Status, elapsed *prometheusElapsed) []byte {
+	var jobs []prometheusJobMetrics
+	for _, js := range status.Jobs {
+		metrics := newPrometheusJobMetrics(js)
+		metrics.Elapsed = elapsed.Histogram(js.Name)
+		jobs = append(jobs, metrics)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+
+	buffer := new(bytes.Buffer)
+	writePrometheusHeader(buffer, "cron_job_successes_total", "counter", "The number of job invocations that completed successfully.")
+	for _, job := range jobs {
+		fmt.Fprintf(buffer, "cron_job_successes_total{job=%s} %d\n", prometheusLabelValue(job.Name), job.Successes)
+	}
+	writePrometheusHeader(buffer, "cron_job_failures_total", "counter", "The number of job invocations that failed.")
+	for _, job := range jobs {
+		fmt.Fprintf(buffer, "cron_job_failures_total{job=%s} %d\n", prometheusLabelValue(job.Name), job.Failures)
+	}
+	writePrometheusHeader(buffer, "cron_job_running", "gauge", "The number of job invocations currently running.")
+	for _, job := range jobs {
+		fmt.Fprintf(buffer, "cron_job_running{job=%s} %d\n", prometheusLabelValue(job.Name), job.Running)
+	}
+	writePrometheusHeader(buffer, "cron_job_elapsed_seconds", "histogram", "The elapsed time of finished job invocations.")
+	for _, job := range jobs {
+		writePrometheusHistogram(buffer, "cron_job_elapsed_seconds", prometheusLabelValue(job.Name), job.Elapsed)
+	}
+	return buffer.Bytes()
+}
+
+// newPrometheusJobMetrics returns the metrics for a job scheduler.
+func newPrometheusJobMetrics(js *cron.JobScheduler) prometheusJobMetrics {
+	js.Lock()
+	defer js.Unlock()
+
+	return prometheusJobMetrics{
+		Name:      js.Name,
+		Successes: js.Successes,
+		Failures:  js.Failures,
+		Running:   js.ActiveRuns,
+	}
+}
+
+func writePrometheusHeader(buffer *bytes.Buffer, name, metricType, help string) {
+	fmt.Fprintf(buffer, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(buffer, "# TYPE %s %s\n", name, metricType)
+}
+
+func writePrometheusHistogram(buffer *bytes.Buffer, name, job string, histogram prometheusHistogram) {
+	for index, bound := range PrometheusElapsedBuckets {
+		var count int
+		if index < len(histogram.Counts) {
+			count = histogram.Counts[index]
+		}
+		fmt.Fprintf(buffer, "%s_bucket{job=%s,le=\"%s\"} %d\n", name, job, strconv.FormatFloat(bound, 'g', -1, 64), count)
+	}
+	fmt.Fprintf(buffer, "%s_bucket{job=%s,le=\"+Inf\"} %d\n", name, job, histogram.Count)
+	fmt.Fprintf(buffer, "%s_sum{job=%s} %s\n", name, job, strconv.FormatFloat(histogram.Sum, 'g', -1, 64))
+	fmt.Fprintf(buffer, "%s_count{job=%s} %d\n", name, job, histogram.Count)
+}
+
+// prometheusLabelValue returns a quoted label value, escaping backslashes, quotes and newlines.
+func prometheusLabelValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
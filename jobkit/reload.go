@@ -0,0 +1,95 @@
+package jobkit
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/blend/go-sdk/cron"
+	"github.com/blend/go-sdk/logger"
+)
+
+// JobsLoader returns the jobs to load, typically by re-reading a config file.
+type JobsLoader func() ([]cron.Job, error)
+
+// Reload calls the loader and replaces the jobs loaded in the job manager with the result, logging what changed.
+// If the loader returns an error (e.g. the config is malformed), the error is logged and
+// the job manager keeps running the jobs it already has.
+func Reload(jm *cron.JobManager, log logger.Log, loader JobsLoader) error {
+	jobs, err := loader()
+	if err != nil {
+		logger.MaybeError(log, err)
+		return err
+	}
+
+	existing := map[string]string{}
+	for _, js := range jm.Status().Jobs {
+		existing[js.Name] = scheduleString(js.Schedule)
+	}
+
+	if err := jm.Reload(jobs...); err != nil {
+		logger.MaybeError(log, err)
+		return err
+	}
+
+	loaded := map[string]bool{}
+	for _, job := range jobs {
+		jobName := job.Name()
+		loaded[jobName] = true
+
+		var schedule string
+		if typed, ok := job.(cron.ScheduleProvider); ok {
+			schedule = scheduleString(typed.Schedule())
+		}
+		previous, hadJob := existing[jobName]
+		if !hadJob {
+			logger.MaybeInfof(log, "reload: added job `%s` with schedule `%s`", jobName, schedule)
+		} else if previous != schedule {
+			logger.MaybeInfof(log, "reload: changed job `%s` schedule from `%s` to `%s`", jobName, previous, schedule)
+		} else {
+			logger.MaybeInfof(log, "reload: reloaded job `%s`", jobName)
+		}
+	}
+	for jobName := range existing {
+		if !loaded[jobName] {
+			logger.MaybeInfof(log, "reload: removed job `%s`", jobName)
+		}
+	}
+	return nil
+}
+
+// ReloadOnSignal calls `Reload` every time the process receives a SIGHUP.
+// It returns a function that stops listening for the signal.
+func ReloadOnSignal(jm *cron.JobManager, log logger.Log, loader JobsLoader) (stop func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-signals:
+				logger.MaybeInfof(log, "reload: received SIGHUP, reloading jobs")
+				Reload(jm, log, loader)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
+
+func scheduleString(schedule cron.Schedule) string {
+	if schedule == nil {
+		return ""
+	}
+	if typed, ok := schedule.(fmt.Stringer); ok {
+		return typed.String()
+	}
+	return fmt.Sprintf("%T", schedule)
+}
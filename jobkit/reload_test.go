@@ -0,0 +1,59 @@
+package jobkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/cron"
+)
+
+func TestReload(t *testing.T) {
+	assert := assert.New(t)
+
+	action := func(_ context.Context) error { return nil }
+	configs := []JobConfig{
+		{Name: "test-0", Schedule: "*/5 * * * * * *"},
+		{Name: "test-1", Schedule: "*/5 * * * * * *"},
+	}
+	loader := func() ([]cron.Job, error) {
+		var jobs []cron.Job
+		for index := range configs {
+			job, err := New(&configs[index], &Config{}, action)
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, job)
+		}
+		return jobs, nil
+	}
+
+	jm := cron.New()
+	assert.Nil(Reload(jm, nil, loader))
+	assert.True(jm.HasJob("test-0"))
+	assert.True(jm.HasJob("test-1"))
+
+	configs = []JobConfig{
+		{Name: "test-0", Schedule: "*/10 * * * * * *"},
+		{Name: "test-2", Schedule: "*/5 * * * * * *"},
+	}
+	assert.Nil(Reload(jm, nil, loader))
+	assert.True(jm.HasJob("test-0"))
+	assert.False(jm.HasJob("test-1"))
+	assert.True(jm.HasJob("test-2"))
+
+	js, err := jm.Job("test-0")
+	assert.Nil(err)
+	expected, err := cron.ParseString("*/10 * * * * * *")
+	assert.Nil(err)
+	assert.Equal(scheduleString(expected), scheduleString(js.Schedule))
+
+	// a malformed config should leave the current jobs in place.
+	configs = []JobConfig{
+		{Name: "test-3", Schedule: "not a schedule"},
+	}
+	assert.NotNil(Reload(jm, nil, loader))
+	assert.True(jm.HasJob("test-0"))
+	assert.True(jm.HasJob("test-2"))
+	assert.False(jm.HasJob("test-3"))
+}
@@ -0,0 +1,46 @@
+package jobkit
+
+import "github.com/blend/go-sdk/cron"
+
+// IndexViewModel is the view model for the management server index page.
+type IndexViewModel struct {
+	// Status is the invocation status the job histories are filtered by, if set.
+	Status cron.JobStatus
+	Jobs   []JobViewModel
+}
+
+// JobViewModel is a job as rendered by the index page, with its (optionally filtered) history.
+type JobViewModel struct {
+	*cron.JobScheduler
+	History []cron.JobInvocation
+}
+
+// indexViewModel returns the index view model, filtering each job's history
+// to the invocations with a given status if it is set.
+func indexViewModel(jm *cron.JobManager, status cron.JobStatus) IndexViewModel {
+	viewModel := IndexViewModel{
+		Status: status,
+	}
+	for _, js := range jm.Status().Jobs {
+		viewModel.Jobs = append(viewModel.Jobs, JobViewModel{
+			JobScheduler: js,
+			History:      filterHistory(js, status),
+		})
+	}
+	return viewModel
+}
+
+// filterHistory returns a copy of a job's history, including only the invocations
+// with a given status if it is set.
+func filterHistory(js *cron.JobScheduler, status cron.JobStatus) []cron.JobInvocation {
+	js.Lock()
+	defer js.Unlock()
+
+	var history []cron.JobInvocation
+	for _, ji := range js.History {
+		if status == "" || ji.Status == status {
+			history = append(history, ji)
+		}
+	}
+	return history
+}
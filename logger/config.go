@@ -31,6 +31,7 @@ type Config struct {
 	OutputFormat       string   `json:"outputFormat,omitempty" yaml:"outputFormat,omitempty" env:"LOG_FORMAT"`
 	Flags              []string `json:"flags,omitempty" yaml:"flags,omitempty" env:"LOG_EVENTS,csv"`
 	HiddenFlags        []string `json:"hiddenFlags,omitempty" yaml:"hiddenFlags,omitempty" env:"LOG_HIDDEN,csv"`
+	MinFlag            string   `json:"minFlag,omitempty" yaml:"minFlag,omitempty" env:"LOG_MIN_FLAG"`
 	RecoverPanics      *bool    `json:"recoverPanics,omitempty" yaml:"recoverPanics,omitempty" env:"LOG_RECOVER"`
 	WriteQueueDepth    int      `json:"writeQueueDepth,omitempty" yaml:"writeQueueDepth,omitempty" env:"LOG_WRITE_QUEUE_DEPTH"`
 	ListenerQueueDepth int      `json:"listenerQueueDepth,omitempty" yaml:"listenerQueueDepth,omitempty" env:"LOG_LISTENER_QUEUE_DEPTH"`
@@ -71,6 +72,11 @@ func (c Config) GetHiddenFlags() []string {
 	return AsStrings(DefaultHiddenFlags...)
 }
 
+// GetMinFlag returns the minimum flag severity, or an empty string if it's unset.
+func (c Config) GetMinFlag() string {
+	return strings.ToLower(c.MinFlag)
+}
+
 // GetRecoverPanics returns a field value or a default.
 func (c Config) GetRecoverPanics(defaults ...bool) bool {
 	if c.RecoverPanics != nil {
@@ -188,7 +194,8 @@ func NewJSONWriterConfigFromEnv() *JSONWriterConfig {
 
 // JSONWriterConfig is the config for a json writer.
 type JSONWriterConfig struct {
-	Pretty *bool `json:"pretty,omitempty" yaml:"pretty,omitempty" env:"LOG_JSON_PRETTY"`
+	Pretty           *bool `json:"pretty,omitempty" yaml:"pretty,omitempty" env:"LOG_JSON_PRETTY"`
+	IncludeTimestamp *bool `json:"includeTimestamp,omitempty" yaml:"includeTimestamp,omitempty" env:"LOG_JSON_INCLUDE_TIMESTAMP"`
 }
 
 // GetPretty returns a field value or a default.
@@ -201,3 +208,14 @@ func (jwc JSONWriterConfig) GetPretty(defaults ...bool) bool {
 	}
 	return DefaultJSONWriterPretty
 }
+
+// GetIncludeTimestamp returns a field value or a default.
+func (jwc JSONWriterConfig) GetIncludeTimestamp(defaults ...bool) bool {
+	if jwc.IncludeTimestamp != nil {
+		return *jwc.IncludeTimestamp
+	}
+	if len(defaults) > 0 {
+		return defaults[0]
+	}
+	return DefaultJSONIncludeTimestamp
+}
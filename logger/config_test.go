@@ -39,7 +39,8 @@ func TestNewConfigFlags(t *testing.T) {
 	assert := assert.New(t)
 
 	cfg := &Config{Flags: []string{"all", "-debug"}}
-	log := NewFromConfig(cfg)
+	log, err := NewFromConfig(cfg)
+	assert.Nil(err)
 	defer log.Close()
 
 	assert.True(log.IsEnabled(Silly))
@@ -131,8 +132,9 @@ func TestNewConfigFromEnvWithVars(t *testing.T) {
 	assert := assert.New(t)
 
 	env.SetEnv(env.Vars{
-		"LOG_EVENTS": "info,debug,error,test",
-		"LOG_HIDDEN": "debug",
+		"LOG_EVENTS":   "info,debug,error,test",
+		"LOG_HIDDEN":   "debug",
+		"LOG_MIN_FLAG": "Warning",
 	})
 	defer env.Restore()
 
@@ -155,7 +157,21 @@ func TestNewConfigFromEnvWithVars(t *testing.T) {
 	assert.None(cfg.GetFlags(), func(v interface{}) bool {
 		return v.(string) == "fatal"
 	})
+	assert.Equal("warning", cfg.GetMinFlag())
+	assert.Equal(Warning, MustNewFromConfig(cfg).MinFlag())
+}
+
+func TestNewFromConfigInvalidMinFlag(t *testing.T) {
+	assert := assert.New(t)
+
+	log, err := NewFromConfig(&Config{MinFlag: "warn"})
+	assert.NotNil(err)
+	assert.Nil(log)
+	assert.Contains(err.Error(), `"warn"`)
 
+	log, err = NewFromConfig(&Config{MinFlag: "Error"})
+	assert.Nil(err)
+	assert.Equal(Error, log.MinFlag())
 }
 
 func TestGetWritersWithOutputFormat(t *testing.T) {
@@ -184,6 +200,9 @@ func TestNewJSONWriterConfigFromEnv(t *testing.T) {
 	defer env.Restore()
 
 	env.Env().Set("LOG_JSON_PRETTY", "false")
+	env.Env().Set("LOG_JSON_INCLUDE_TIMESTAMP", "true")
 	cfg := NewJSONWriterConfigFromEnv()
 	assert.False(cfg.GetPretty())
+	assert.True(cfg.GetIncludeTimestamp())
+	assert.True(NewJSONWriterFromConfig(cfg).IncludeTimestamp())
 }
@@ -0,0 +1,37 @@
+package logger
+
+import "context"
+
+type labelsContextKey struct{}
+
+// WithLabelsContext returns a context that carries labels, which are added to events
+// triggered with the context, e.g. with `TriggerContext`.
+// Labels already on the context are kept, and the given labels take precedence over them.
+func WithLabelsContext(ctx context.Context, labels map[string]string) context.Context {
+	existing := GetLabelsContext(ctx)
+	merged := make(map[string]string, len(existing)+len(labels))
+	for key, value := range existing {
+		merged[key] = value
+	}
+	for key, value := range labels {
+		merged[key] = value
+	}
+	return context.WithValue(ctx, labelsContextKey{}, merged)
+}
+
+// GetLabelsContext returns the labels on a context, or nil if there are none.
+func GetLabelsContext(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	if typed, isTyped := ctx.Value(labelsContextKey{}).(map[string]string); isTyped {
+		return typed
+	}
+	return nil
+}
+
+// injectLabelsContext adds the labels on a context to an event if it supports labels,
+// without overwriting labels already set on the event.
+func injectLabelsContext(ctx context.Context, e Event) {
+	addMissingLabels(e, GetLabelsContext(ctx))
+}
@@ -0,0 +1,54 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestLabelsContext(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(GetLabelsContext(nil))
+	assert.Nil(GetLabelsContext(context.Background()))
+
+	ctx := WithLabelsContext(context.Background(), map[string]string{"request": "foo", "user": "bar"})
+	ctx = WithLabelsContext(ctx, map[string]string{"user": "baz"})
+	assert.Equal(map[string]string{"request": "foo", "user": "baz"}, GetLabelsContext(ctx))
+}
+
+func TestLabelsContextMissingDoesNotAllocate(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx := context.Background()
+	e := Messagef(Info, "test")
+	allocs := testing.AllocsPerRun(100, func() {
+		GetLabelsContext(ctx)
+		injectLabelsContext(ctx, e)
+	})
+	assert.Zero(allocs)
+}
+
+func TestLoggerTriggerContext(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewJSONWriter(buffer)).WithLabels(map[string]string{"service": "foo", "request": "default"})
+	defer log.Close()
+
+	var labels map[string]string
+	log.Listen(Info, "labels", func(e Event) {
+		labels = e.(EventLabels).Labels()
+	})
+
+	ctx := WithLabelsContext(context.Background(), map[string]string{"request": "abc123", "user": "bar"})
+	e := Messagef(Info, "test")
+	e.AddLabelValue("user", "event")
+	log.SyncTriggerContext(ctx, e)
+
+	assert.Equal(map[string]string{"service": "foo", "request": "abc123", "user": "event"}, labels)
+	assert.Contains(buffer.String(), `"request":"abc123"`)
+	assert.Equal(map[string]string{"request": "abc123", "user": "bar"}, GetLabelsContext(ctx), "the context labels should not change")
+}
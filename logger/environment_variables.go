@@ -8,6 +8,9 @@ const (
 	// EnvVarLogHiddenEvents is the set of flags that should never produce automatic output.
 	EnvVarHiddenEventFlags = "LOG_HIDDEN"
 
+	// EnvVarMinFlag is the env var that sets the minimum flag severity.
+	EnvVarMinFlag = "LOG_MIN_FLAG"
+
 	// EnvVarEvents is the env var that sets the output format.
 	EnvVarFormat = "LOG_FORMAT"
 
@@ -26,4 +29,6 @@ const (
 
 	// EnvVarJSONPretty returns if we should indent json output.
 	EnvVarJSONPretty = "LOG_JSON_PRETTY"
+	// EnvVarJSONIncludeTimestamp returns if we should include the timestamp in json output.
+	EnvVarJSONIncludeTimestamp = "LOG_JSON_INCLUDE_TIMESTAMP"
 )
@@ -8,8 +8,14 @@ var (
 	_ EventHeadings    = &EventMeta{}
 	_ EventLabels      = &EventMeta{}
 	_ EventAnnotations = &EventMeta{}
+	_ EventSequence    = &EventMeta{}
 )
 
+// eventMetaHolder is a type that embeds an event meta.
+type eventMetaHolder interface {
+	eventMeta() *EventMeta
+}
+
 // NewEventMeta returns a new event meta.
 func NewEventMeta(flag Flag) *EventMeta {
 	return &EventMeta{
@@ -25,6 +31,7 @@ type EventMeta struct {
 	flag          Flag
 	flagTextColor AnsiColor
 	ts            time.Time
+	seq           uint64
 	headings      []string
 	entity        string
 	labels        map[string]string
@@ -55,6 +62,12 @@ func (em *EventMeta) Timestamp() time.Time { return em.ts }
 // SetTimestamp sets the timestamp.
 func (em *EventMeta) SetTimestamp(ts time.Time) { em.ts = ts }
 
+// Sequence returns the event sequence number, or zero if the event wasn't tagged with one.
+func (em *EventMeta) Sequence() uint64 { return em.seq }
+
+// SetSequence sets the sequence number.
+func (em *EventMeta) SetSequence(seq uint64) { em.seq = seq }
+
 // AddLabelValue adds a label value
 func (em *EventMeta) AddLabelValue(key, value string) { em.labels[key] = value }
 
@@ -78,3 +91,6 @@ func (em *EventMeta) SetEntity(value string) { em.entity = value }
 
 // Entity returns an entity value.
 func (em *EventMeta) Entity() string { return em.entity }
+
+// eventMeta returns the event meta; it lets the logger modify the meta of events that embed it.
+func (em *EventMeta) eventMeta() *EventMeta { return em }
@@ -52,3 +52,30 @@ func AsStrings(values ...Flag) (output []string) {
 	}
 	return
 }
+
+// FlagSeverities are the severities of flags, used to compare flags with a minimum flag.
+// A higher value is more severe. Flags that aren't listed, including custom event flags
+// like `HTTPRequest` or `Audit`, rank the same as `Info`; add a custom flag to the map
+// to rank it differently. A minimum flag read from config must be listed.
+var FlagSeverities = map[Flag]int{
+	Silly:   0,
+	Debug:   1,
+	Info:    2,
+	Warning: 3,
+	Error:   4,
+	Fatal:   5,
+}
+
+// HasSeverity returns if a flag is listed in `FlagSeverities`.
+func HasSeverity(flag Flag) bool {
+	_, ok := FlagSeverities[flag]
+	return ok
+}
+
+// FlagSeverity returns the severity of a flag, or the severity of `Info` if the flag isn't listed.
+func FlagSeverity(flag Flag) int {
+	if severity, ok := FlagSeverities[flag]; ok {
+		return severity
+	}
+	return FlagSeverities[Info]
+}
@@ -15,3 +15,12 @@ func TestAsFlags(t *testing.T) {
 	flags := []string{"foo", "bar", "baz", "buzz"}
 	assert.Equal(flags, AsStrings(AsFlags(flags...)...))
 }
+
+func TestFlagSeverity(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(HasSeverity(Warning))
+	assert.False(HasSeverity(HTTPRequest))
+	assert.True(FlagSeverity(Error) > FlagSeverity(Warning))
+	assert.Equal(FlagSeverity(Info), FlagSeverity(HTTPRequest), "custom flags should rank with info")
+}
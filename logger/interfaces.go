@@ -23,6 +23,9 @@ type EventMetaProvider interface {
 // Listener is a function that can be triggered by events.
 type Listener func(e Event)
 
+// Enricher is a function that modifies the meta of events before they're written or sent to listeners.
+type Enricher func(EventMeta) EventMeta
+
 // EventEntity is a type that provides an entity value.
 type EventEntity interface {
 	SetEntity(string)
@@ -40,6 +43,12 @@ type EventLabels interface {
 	Labels() map[string]string
 }
 
+// EventSequence is a type that can be tagged with a sequence number, see `Logger.WithSequenceNumbers`.
+type EventSequence interface {
+	SetSequence(uint64)
+	Sequence() uint64
+}
+
 // EventAnnotations is a type that provides annotations.
 type EventAnnotations interface {
 	Annotations() map[string]string
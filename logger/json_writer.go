@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"time"
 )
 
 const (
@@ -11,6 +12,8 @@ const (
 	JSONFieldFlag = "flag"
 	// JSONFieldTimestamp is a common json field.
 	JSONFieldTimestamp = "_timestamp"
+	// JSONFieldSequence is a common json field.
+	JSONFieldSequence = "_seq"
 	// JSONFieldMessage is a common json field.
 	JSONFieldMessage = "message"
 	// JSONFieldElapsed is a common json field.
@@ -19,6 +22,10 @@ const (
 	JSONFieldErr = "err"
 	// JSONFieldEventHeadings is a common json field.
 	JSONFieldEventHeadings = "event-headings"
+	// JSONFieldLabels is a common json field.
+	JSONFieldLabels = "labels"
+	// JSONFieldAnnotations is a common json field.
+	JSONFieldAnnotations = "annotations"
 
 	// DefaultJSONWriterPretty is a default.
 	DefaultJSONWriterPretty = false
@@ -62,9 +69,10 @@ func NewJSONWriterFromEnv() *JSONWriter {
 // NewJSONWriterFromConfig returns a new json writer from a config.
 func NewJSONWriterFromConfig(cfg *JSONWriterConfig) *JSONWriter {
 	return &JSONWriter{
-		output:      NewInterlockedWriter(os.Stdout),
-		errorOutput: NewInterlockedWriter(os.Stderr),
-		pretty:      cfg.GetPretty(),
+		output:           NewInterlockedWriter(os.Stdout),
+		errorOutput:      NewInterlockedWriter(os.Stderr),
+		pretty:           cfg.GetPretty(),
+		includeTimestamp: cfg.GetIncludeTimestamp(),
 	}
 }
 
@@ -138,6 +146,8 @@ func (jw *JSONWriter) WriteError(e Event) error {
 	return jw.write(jw.ErrorOutput(), e)
 }
 
+// write encodes an event as a single json object (per line, unless pretty).
+// Label and annotation maps are encoded with sorted keys, and the timestamp is RFC3339 with nanoseconds.
 func (jw *JSONWriter) write(output io.Writer, e Event) error {
 	encoder := json.NewEncoder(output)
 	if jw.pretty {
@@ -149,9 +159,18 @@ func (jw *JSONWriter) write(output io.Writer, e Event) error {
 		if typed, isTyped := e.(EventHeadings); isTyped && len(typed.Headings()) > 0 {
 			fields[JSONFieldEventHeadings] = typed.Headings()
 		}
+		if typed, isTyped := e.(EventLabels); isTyped && len(typed.Labels()) > 0 {
+			fields[JSONFieldLabels] = typed.Labels()
+		}
+		if typed, isTyped := e.(EventAnnotations); isTyped && len(typed.Annotations()) > 0 {
+			fields[JSONFieldAnnotations] = typed.Annotations()
+		}
+		if typed, isTyped := e.(EventSequence); isTyped && typed.Sequence() > 0 {
+			fields[JSONFieldSequence] = typed.Sequence()
+		}
 		fields[JSONFieldFlag] = e.Flag()
 		if jw.includeTimestamp {
-			fields[JSONFieldTimestamp] = e.Timestamp()
+			fields[JSONFieldTimestamp] = e.Timestamp().Format(time.RFC3339Nano)
 		}
 		return encoder.Encode(fields)
 	}
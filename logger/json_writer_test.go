@@ -42,6 +42,23 @@ func TestJSONWriterPretty(t *testing.T) {
 	assert.Equal("test", verify["message"])
 }
 
+func TestJSONWriterLabelsAnnotations(t *testing.T) {
+	assert := assert.New(t)
+
+	output := bytes.NewBuffer(nil)
+	jw := NewJSONWriter(output).WithIncludeTimestamp(true)
+
+	e := Messagef(Info, "test")
+	e.SetTimestamp(time.Date(2019, 05, 04, 12, 30, 15, 123456789, time.UTC))
+	e.AddLabelValue("service", "foo")
+	e.AddLabelValue("env", "test")
+	e.AddAnnotationValue("zone", "us-east-1a")
+	e.AddAnnotationValue("build", "abc123")
+	assert.Nil(jw.Write(e))
+
+	assert.Equal(`{"_timestamp":"2019-05-04T12:30:15.123456789Z","annotations":{"build":"abc123","zone":"us-east-1a"},"flag":"info","labels":{"env":"test","service":"foo"},"message":"test"}`+"\n", output.String())
+}
+
 type bareEvent struct {
 	Foo string `json:"foo"`
 }
@@ -49,6 +66,20 @@ type bareEvent struct {
 func (be bareEvent) Flag() Flag           { return Info }
 func (be bareEvent) Timestamp() time.Time { return time.Now().UTC() }
 
+func TestJSONWriterSequence(t *testing.T) {
+	assert := assert.New(t)
+
+	output := bytes.NewBuffer(nil)
+	jw := NewJSONWriter(output)
+	e := Messagef(Info, "test")
+	e.SetSequence(42)
+	assert.Nil(jw.Write(e))
+
+	var verify JSONObj
+	assert.Nil(json.Unmarshal(output.Bytes(), &verify))
+	assert.Equal(42, verify[JSONFieldSequence])
+}
+
 func TestJSONWriterBareObject(t *testing.T) {
 	assert := assert.New(t)
 
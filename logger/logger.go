@@ -1,11 +1,15 @@
 package logger
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 const (
@@ -15,6 +19,12 @@ const (
 
 	// DefaultRecoverPanics is a default.
 	DefaultRecoverPanics = true
+
+	// DefaultWriteErrorNoticeInterval is the default minimum interval between write error notices.
+	DefaultWriteErrorNoticeInterval = 10 * time.Second
+
+	// DefaultFatalFlushTimeout is the default time to wait for output and listeners to be flushed after a fatal event.
+	DefaultFatalFlushTimeout = 5 * time.Second
 )
 
 var (
@@ -44,7 +54,11 @@ func New(flags ...Flag) *Logger {
 }
 
 // NewFromConfig returns a new logger from a config.
-func NewFromConfig(cfg *Config) *Logger {
+// It returns an error if the config's min flag isn't listed in `FlagSeverities`.
+func NewFromConfig(cfg *Config) (*Logger, error) {
+	if minFlag := Flag(cfg.GetMinFlag()); minFlag != "" && !HasSeverity(minFlag) {
+		return nil, fmt.Errorf("logger: invalid min flag %q; it must be listed in `FlagSeverities`", minFlag)
+	}
 	l := &Logger{
 		recoverPanics:            DefaultRecoverPanics,
 		flags:                    NewFlagSetFromValues(cfg.GetFlags()...),
@@ -55,10 +69,20 @@ func NewFromConfig(cfg *Config) *Logger {
 	l.writeWorker.Start()
 	return l.
 		WithHeading(cfg.GetHeading()).
+		WithMinFlag(Flag(cfg.GetMinFlag())).
 		WithRecoverPanics(cfg.GetRecoverPanics()).
 		WithHiddenFlags(NewFlagSetFromValues(cfg.GetHiddenFlags()...)).
-		WithWriters(cfg.GetWriters()...)
+		WithWriters(cfg.GetWriters()...), nil
+}
 
+// MustNewFromConfig returns a new logger from a config,
+// and panics if there is an error.
+func MustNewFromConfig(cfg *Config) *Logger {
+	l, err := NewFromConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return l
 }
 
 // NewFromEnv returns a new agent with settings read from the environment,
@@ -68,7 +92,7 @@ func NewFromEnv() (*Logger, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewFromConfig(cfg), nil
+	return NewFromConfig(cfg)
 }
 
 // MustNewFromEnv returns a new logger based on settings from the environment.
@@ -77,7 +101,7 @@ func MustNewFromEnv() *Logger {
 	if err != nil {
 		panic(err)
 	}
-	return NewFromConfig(cfg)
+	return MustNewFromConfig(cfg)
 }
 
 // All returns a valid logger that fires any and all events, and includes a writer.
@@ -125,6 +149,7 @@ type Logger struct {
 	writers []Writer
 
 	heading                  string
+	labels                   map[string]string
 	writeWorkerQueueDepth    int
 	listenerWorkerQueueDepth int
 
@@ -132,6 +157,7 @@ type Logger struct {
 
 	flagsLock sync.Mutex
 	flags     *FlagSet
+	minFlag   Flag
 
 	hiddenFlagsLock sync.Mutex
 	hiddenFlags     *FlagSet
@@ -143,6 +169,24 @@ type Logger struct {
 	writeWorker     *Worker
 
 	recoverPanics bool
+
+	sequenceNumbers bool
+	sequence        uint64
+
+	samplerLock sync.Mutex
+	sampler     *Sampler
+
+	enrichersLock sync.Mutex
+	enrichers     []Enricher
+
+	fatalExit         bool
+	fatalFlushTimeout time.Duration
+	exit              func(int)
+
+	writeErrorOutput         io.Writer
+	writeErrorNoticeInterval time.Duration
+	writeErrors              int64
+	lastWriteErrorNotice     int64
 }
 
 // CanStart returns if the latch can start.
@@ -166,6 +210,50 @@ func (l *Logger) Heading() string {
 	return l.heading
 }
 
+// WithSequenceNumbers sets if events are tagged with an increasing sequence number as they're queued to be written.
+// Async events are tagged in the order they're written, so the sequence numbers in the output are strictly increasing,
+// even if the events were triggered concurrently and their timestamps are out of order.
+func (l *Logger) WithSequenceNumbers(enabled bool) *Logger {
+	l.sequenceNumbers = enabled
+	return l
+}
+
+// SequenceNumbers returns if events are tagged with sequence numbers.
+func (l *Logger) SequenceNumbers() bool {
+	return l.sequenceNumbers
+}
+
+// WithLabels sets default labels that are added to every event that supports labels.
+// Labels set on an event take precedence over the default labels.
+func (l *Logger) WithLabels(labels map[string]string) *Logger {
+	l.labels = make(map[string]string, len(labels))
+	for key, value := range labels {
+		l.labels[key] = value
+	}
+	return l
+}
+
+// Labels returns the default labels.
+func (l *Logger) Labels() map[string]string {
+	return l.labels
+}
+
+// AddEnricher adds an enricher that modifies the meta of every event before it's written or sent to listeners,
+// e.g. to add a hostname label. Enrichers run in the order they were added.
+// Events that don't embed an `EventMeta` are not enriched.
+func (l *Logger) AddEnricher(enricher Enricher) {
+	l.enrichersLock.Lock()
+	defer l.enrichersLock.Unlock()
+	l.enrichers = append(l.enrichers, enricher)
+}
+
+// Enrichers returns the enrichers.
+func (l *Logger) Enrichers() []Enricher {
+	l.enrichersLock.Lock()
+	defer l.enrichersLock.Unlock()
+	return append([]Enricher(nil), l.enrichers...)
+}
+
 // WithWriteWorkerQueueDepth sets the worker queue depth.
 func (l *Logger) WithWriteWorkerQueueDepth(queueDepth int) *Logger {
 	l.writeWorkerQueueDepth = queueDepth
@@ -205,6 +293,84 @@ func (l *Logger) WithWriter(writer Writer) *Logger {
 	return l
 }
 
+// WithSampler sets the sampler, which decides if events should be triggered
+// for high-frequency flags before they're formatted or written.
+func (l *Logger) WithSampler(sampler *Sampler) *Logger {
+	l.samplerLock.Lock()
+	defer l.samplerLock.Unlock()
+	l.sampler = sampler
+	return l
+}
+
+// Sampler returns the sampler.
+func (l *Logger) Sampler() *Sampler {
+	l.samplerLock.Lock()
+	defer l.samplerLock.Unlock()
+	return l.sampler
+}
+
+// WithWriteErrorOutput sets the output that write error notices are written to.
+// If unset, notices are written to stderr.
+func (l *Logger) WithWriteErrorOutput(output io.Writer) *Logger {
+	l.writeErrorOutput = output
+	return l
+}
+
+// WriteErrorOutput returns the output that write error notices are written to.
+func (l *Logger) WriteErrorOutput() io.Writer {
+	if l.writeErrorOutput != nil {
+		return l.writeErrorOutput
+	}
+	return os.Stderr
+}
+
+// WithWriteErrorNoticeInterval sets the minimum interval between write error notices.
+func (l *Logger) WithWriteErrorNoticeInterval(interval time.Duration) *Logger {
+	l.writeErrorNoticeInterval = interval
+	return l
+}
+
+// WriteErrorNoticeInterval returns the minimum interval between write error notices.
+func (l *Logger) WriteErrorNoticeInterval() time.Duration {
+	if l.writeErrorNoticeInterval > 0 {
+		return l.writeErrorNoticeInterval
+	}
+	return DefaultWriteErrorNoticeInterval
+}
+
+// WriteErrors returns the number of consecutive writes that have failed.
+// It is reset when an event is written to every writer without error.
+func (l *Logger) WriteErrors() int64 {
+	return atomic.LoadInt64(&l.writeErrors)
+}
+
+// WithFatalExit sets if the process should exit, with code 1, after a fatal event is triggered
+// with `Fatal`, `Fatalf`, `SyncFatal` or `SyncFatalf` and the output is flushed.
+// Output is flushed after a fatal event whether or not fatal exit is enabled.
+func (l *Logger) WithFatalExit(enabled bool) *Logger {
+	l.fatalExit = enabled
+	return l
+}
+
+// FatalExit returns if the process should exit after a fatal event.
+func (l *Logger) FatalExit() bool {
+	return l.fatalExit
+}
+
+// WithFatalFlushTimeout sets how long to wait for output and listeners to be flushed after a fatal event.
+func (l *Logger) WithFatalFlushTimeout(timeout time.Duration) *Logger {
+	l.fatalFlushTimeout = timeout
+	return l
+}
+
+// FatalFlushTimeout returns how long to wait for output and listeners to be flushed after a fatal event.
+func (l *Logger) FatalFlushTimeout() time.Duration {
+	if l.fatalFlushTimeout > 0 {
+		return l.fatalFlushTimeout
+	}
+	return DefaultFatalFlushTimeout
+}
+
 // RecoversPanics returns if we should recover panics in logger listeners.
 func (l *Logger) RecoversPanics() bool {
 	return l.recoverPanics
@@ -229,6 +395,23 @@ func (l *Logger) WithFlags(flags *FlagSet) *Logger {
 	return l
 }
 
+// WithMinFlag sets a severity threshold; flags less severe than the minimum flag are disabled
+// regardless of the enabled flag set. See `FlagSeverities` for how flags, including custom flags,
+// are ordered. An empty flag removes the threshold.
+func (l *Logger) WithMinFlag(flag Flag) *Logger {
+	l.flagsLock.Lock()
+	defer l.flagsLock.Unlock()
+	l.minFlag = flag
+	return l
+}
+
+// MinFlag returns the severity threshold.
+func (l *Logger) MinFlag() Flag {
+	l.flagsLock.Lock()
+	defer l.flagsLock.Unlock()
+	return l.minFlag
+}
+
 // WithHiddenFlags sets the hidden flag set.
 // These flags mark events as to be omitted from output.
 func (l *Logger) WithHiddenFlags(flags *FlagSet) *Logger {
@@ -304,6 +487,9 @@ func (l *Logger) IsEnabled(flag Flag) (enabled bool) {
 		return
 	}
 	enabled = l.flags.IsEnabled(flag)
+	if enabled && l.minFlag != "" {
+		enabled = FlagSeverity(flag) >= FlagSeverity(l.minFlag)
+	}
 	l.flagsLock.Unlock()
 	return
 }
@@ -468,7 +654,47 @@ func (l *Logger) SyncTrigger(e Event) {
 	l.trigger(false, e)
 }
 
+// TriggerContext fires the listeners for a given event asynchronously,
+// adding the labels on the context to the event. See `WithLabelsContext`.
+func (l *Logger) TriggerContext(ctx context.Context, e Event) {
+	injectLabelsContext(ctx, e)
+	l.trigger(true, e)
+}
+
+// SyncTriggerContext fires the listeners for a given event synchronously,
+// adding the labels on the context to the event. See `WithLabelsContext`.
+func (l *Logger) SyncTriggerContext(ctx context.Context, e Event) {
+	injectLabelsContext(ctx, e)
+	l.trigger(false, e)
+}
+
+// trigger samples an event and dispatches it.
 func (l *Logger) trigger(async bool, e Event) {
+	if !l.sampled(e.Flag()) {
+		return
+	}
+	l.dispatch(async, e)
+}
+
+// triggerf samples a message before formatting it, and dispatches it.
+func (l *Logger) triggerf(async bool, flag Flag, format string, args ...interface{}) {
+	if !l.IsEnabled(flag) || !l.sampled(flag) {
+		return
+	}
+	l.dispatch(async, Messagef(flag, format, args...))
+}
+
+// sampled returns if the sampler lets through an event with a given flag.
+// Disabled flags are not counted by the sampler.
+func (l *Logger) sampled(flag Flag) bool {
+	sampler := l.Sampler()
+	if sampler == nil || !l.IsEnabled(flag) {
+		return true
+	}
+	return sampler.Allow(flag)
+}
+
+func (l *Logger) dispatch(async bool, e Event) {
 	if !async && l.recoverPanics {
 		defer func() {
 			if r := recover(); r != nil {
@@ -496,6 +722,8 @@ func (l *Logger) trigger(async bool, e Event) {
 				}
 			}
 		}
+		l.injectLabels(e)
+		l.enrich(e)
 
 		var workers map[string]*Worker
 		l.workersLock.Lock()
@@ -525,45 +753,104 @@ func (l *Logger) trigger(async bool, e Event) {
 		}
 
 		if async {
-			l.writeWorker.Work <- e
+			// the sequence number is set under the lock so it matches the write queue order.
+			l.writeWorkerLock.Lock()
+			if l.writeWorker != nil {
+				l.tagSequence(e)
+				l.writeWorker.Work <- e
+			}
+			l.writeWorkerLock.Unlock()
 		} else {
+			l.tagSequence(e)
 			l.Write(e)
 		}
 	}
 }
 
+// tagSequence sets the next sequence number on an event if sequence numbers are enabled and the event supports them.
+func (l *Logger) tagSequence(e Event) {
+	if !l.sequenceNumbers {
+		return
+	}
+	if typed, isTyped := e.(EventSequence); isTyped {
+		typed.SetSequence(atomic.AddUint64(&l.sequence, 1))
+	}
+}
+
+// injectLabels adds the default labels to an event if it supports labels,
+// without overwriting labels already set on the event.
+func (l *Logger) injectLabels(e Event) {
+	addMissingLabels(e, l.labels)
+}
+
+// enrich runs the enrichers on the event meta, if the event embeds one.
+func (l *Logger) enrich(e Event) {
+	enrichers := l.Enrichers()
+	if len(enrichers) == 0 {
+		return
+	}
+	typed, isTyped := e.(eventMetaHolder)
+	if !isTyped {
+		return
+	}
+	meta := typed.eventMeta()
+	if meta == nil {
+		return
+	}
+	for _, enricher := range enrichers {
+		*meta = enricher(*meta)
+	}
+}
+
+// addMissingLabels adds labels to an event if it supports labels, skipping labels already set on the event.
+func addMissingLabels(e Event, labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+	typed, isTyped := e.(EventLabels)
+	if !isTyped || typed.Labels() == nil {
+		return
+	}
+	eventLabels := typed.Labels()
+	for key, value := range labels {
+		if _, hasKey := eventLabels[key]; !hasKey {
+			eventLabels[key] = value
+		}
+	}
+}
+
 // --------------------------------------------------------------------------------
 // Builtin Flag Handlers (infof, debugf etc.)
 // --------------------------------------------------------------------------------
 
 // Sillyf logs an incredibly verbose message to the output stream.
 func (l *Logger) Sillyf(format string, args ...interface{}) {
-	l.trigger(true, Messagef(Silly, format, args...))
+	l.triggerf(true, Silly, format, args...)
 }
 
 // SyncSillyf logs an incredibly verbose message to the output stream synchronously.
 func (l *Logger) SyncSillyf(format string, args ...interface{}) {
-	l.trigger(false, Messagef(Silly, format, args...))
+	l.triggerf(false, Silly, format, args...)
 }
 
 // Infof logs an informational message to the output stream.
 func (l *Logger) Infof(format string, args ...interface{}) {
-	l.trigger(true, Messagef(Info, format, args...))
+	l.triggerf(true, Info, format, args...)
 }
 
 // SyncInfof logs an informational message to the output stream synchronously.
 func (l *Logger) SyncInfof(format string, args ...interface{}) {
-	l.trigger(false, Messagef(Info, format, args...))
+	l.triggerf(false, Info, format, args...)
 }
 
 // Debugf logs a debug message to the output stream.
 func (l *Logger) Debugf(format string, args ...interface{}) {
-	l.trigger(true, Messagef(Debug, format, args...))
+	l.triggerf(true, Debug, format, args...)
 }
 
 // SyncDebugf logs an debug message to the output stream synchronously.
 func (l *Logger) SyncDebugf(format string, args ...interface{}) {
-	l.trigger(false, Messagef(Debug, format, args...))
+	l.triggerf(false, Debug, format, args...)
 }
 
 // Warningf logs a debug message to the output stream.
@@ -625,44 +912,80 @@ func (l *Logger) ErrorWithReq(err error, req *http.Request) error {
 // Fatalf writes an event to the log and triggers event listeners.
 func (l *Logger) Fatalf(format string, args ...interface{}) {
 	l.trigger(true, Errorf(Fatal, format, args...))
+	l.flushFatal()
 }
 
 // SyncFatalf synchronously triggers a fatal.
 func (l *Logger) SyncFatalf(format string, args ...interface{}) {
 	l.trigger(false, Errorf(Fatal, format, args...))
+	l.flushFatal()
 }
 
 // Fatal logs the result of a panic to std err.
 func (l *Logger) Fatal(err error) error {
 	l.trigger(true, NewErrorEvent(Fatal, err))
+	l.flushFatal()
 	return err
 }
 
 // SyncFatal synchronously logs a fatal to std err.
 func (l *Logger) SyncFatal(err error) error {
 	l.trigger(false, NewErrorEvent(Fatal, err))
+	l.flushFatal()
 	return err
 }
 
 // SyncFatalExit logs the result of a fatal error to std err and calls `exit(1)`
+// once queued output and listener events are flushed, regardless of `WithFatalExit`.
 func (l *Logger) SyncFatalExit(err error) {
 	l.SyncFatal(err)
-	l.Drain()
-	os.Exit(1)
+	l.exitProcess()
 }
 
 // Write writes an event synchronously to the writer either as a normal even or as an error.
+// If a writer returns an error, a notice is written to the write error output (stderr by default),
+// at most once per write error notice interval, and the remaining writers are still written to.
 func (l *Logger) Write(e Event) {
 	ll := len(l.writers)
+	isError := false
 	if typed, isTyped := e.(EventError); isTyped && typed.IsError() {
-		for index := 0; index < ll; index++ {
-			l.writers[index].WriteError(e)
+		isError = true
+	}
+
+	var err, writeErr error
+	failed := false
+	for index := 0; index < ll; index++ {
+		if isError {
+			err = l.writers[index].WriteError(e)
+		} else {
+			err = l.writers[index].Write(e)
 		}
+		if err != nil && !failed {
+			failed = true
+			writeErr = err
+		}
+	}
+	if failed {
+		l.writeErrorNotice(e, writeErr)
 		return
 	}
-	for index := 0; index < ll; index++ {
-		l.writers[index].Write(e)
+	atomic.StoreInt64(&l.writeErrors, 0)
+}
+
+// writeErrorNotice records a failed write and writes a throttled notice about it.
+// A write fails if any writer returns an error; the first error is reported.
+func (l *Logger) writeErrorNotice(e Event, err error) {
+	writeErrors := atomic.AddInt64(&l.writeErrors, 1)
+
+	now := time.Now().UTC().UnixNano()
+	last := atomic.LoadInt64(&l.lastWriteErrorNotice)
+	if last > 0 && time.Duration(now-last) < l.WriteErrorNoticeInterval() {
+		return
 	}
+	if !atomic.CompareAndSwapInt64(&l.lastWriteErrorNotice, last, now) {
+		return
+	}
+	fmt.Fprintf(l.WriteErrorOutput(), "logger: failed to write %s event (%d consecutive write errors): %v\n", e.Flag(), writeErrors, err)
 }
 
 // --------------------------------------------------------------------------------
@@ -730,6 +1053,85 @@ func (l *Logger) Drain() error {
 	return nil
 }
 
+// DrainContext waits for any queued events to be processed, like `Drain`,
+// but returns the context's error if it is done before the events are processed.
+// The queued events are still processed in the background.
+func (l *Logger) DrainContext(ctx context.Context) error {
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		l.Drain()
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flushFatal flushes queued listener events and output after a fatal event, waiting at most
+// the fatal flush timeout, then exits the process if fatal exit is enabled.
+// The logger stays started, so events from other goroutines are still dispatched while it flushes.
+// A fatal event triggered from a listener waits for the timeout, as the listener's own worker
+// cannot be flushed until the listener returns.
+func (l *Logger) flushFatal() {
+	if l.isStarted() {
+		ctx, cancel := context.WithTimeout(context.Background(), l.FatalFlushTimeout())
+		defer cancel()
+		if err := l.flush(ctx); err != nil {
+			fmt.Fprintf(l.WriteErrorOutput(), "logger: flushing output after a fatal event: %v\n", err)
+		}
+	}
+	if l.fatalExit {
+		l.exitProcess()
+	}
+}
+
+// exitProcess exits the process with code 1.
+func (l *Logger) exitProcess() {
+	if l.exit != nil {
+		l.exit(1)
+		return
+	}
+	os.Exit(1)
+}
+
+// flush processes queued listener events, then queued writes, returning the context's error if it is done first.
+// Unlike `Drain`, the logger stays started while it flushes.
+func (l *Logger) flush(ctx context.Context) error {
+	// the workers are collected first, so listeners that trigger events while they're flushed don't deadlock.
+	var workers []*Worker
+	l.workersLock.Lock()
+	for _, flagWorkers := range l.workers {
+		for _, worker := range flagWorkers {
+			workers = append(workers, worker)
+		}
+	}
+	l.workersLock.Unlock()
+
+	l.writeWorkerLock.Lock()
+	writeWorker := l.writeWorker
+	l.writeWorkerLock.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		for _, worker := range workers {
+			worker.Drain()
+		}
+		if writeWorker != nil {
+			writeWorker.Drain()
+		}
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (l *Logger) isStarted() bool {
 	return atomic.LoadInt32(&l.state) == LoggerStarted
 }
@@ -2,10 +2,13 @@ package logger
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -245,6 +248,43 @@ func TestLoggerTriggerUnflagged(t *testing.T) {
 	log.Trigger(Messagef(Error, "Hello World"))
 }
 
+// closingEvent blocks in `IsWritable`, the last check before an event is queued to be written,
+// so the logger can be closed while the event is triggered.
+type closingEvent struct {
+	*MessageEvent
+	checking chan struct{}
+	closed   chan struct{}
+}
+
+func (ce closingEvent) IsWritable() bool {
+	close(ce.checking)
+	<-ce.closed
+	return true
+}
+
+func TestLoggerTriggerDuringClose(t *testing.T) {
+	assert := assert.New(t)
+
+	log := all(new(bytes.Buffer))
+	e := closingEvent{
+		MessageEvent: Messagef(Info, "this is only a test"),
+		checking:     make(chan struct{}),
+		closed:       make(chan struct{}),
+	}
+
+	triggered := make(chan struct{})
+	go func() {
+		defer close(triggered)
+		log.Trigger(e)
+	}()
+	<-e.checking
+
+	// the event should be dropped, not sent to the closed write worker.
+	assert.Nil(log.Close())
+	close(e.closed)
+	<-triggered
+}
+
 func TestLoggerRemoveListeners(t *testing.T) {
 	assert := assert.New(t)
 
@@ -258,6 +298,35 @@ func TestLoggerRemoveListeners(t *testing.T) {
 	assert.True(log.HasListeners(Info))
 }
 
+func TestLoggerWithMinFlag(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithMinFlag(Warning).WithWriter(NewTextWriter(buffer).WithShowTimestamp(false).WithUseColor(false))
+	defer log.Close()
+	assert.Equal(Warning, log.MinFlag())
+
+	assert.False(log.IsEnabled(Silly))
+	assert.False(log.IsEnabled(Debug))
+	assert.False(log.IsEnabled(Info))
+	assert.False(log.IsEnabled(HTTPRequest))
+	assert.True(log.IsEnabled(Warning))
+	assert.True(log.IsEnabled(Error))
+	assert.True(log.IsEnabled(Fatal))
+
+	log.SyncSillyf("silly")
+	log.SyncDebugf("debug")
+	log.SyncInfof("info")
+	assert.Empty(buffer.String())
+
+	log.SyncWarningf("warning")
+	log.SyncErrorf("error")
+	assert.Equal("[warning] warning\n[error] error\n", buffer.String())
+
+	log.WithMinFlag("")
+	assert.True(log.IsEnabled(Info))
+}
+
 func TestLoggerSillyf(t *testing.T) {
 	assert := assert.New(t)
 
@@ -751,6 +820,69 @@ func TestLoggerCanConfigureMultipleWriters(t *testing.T) {
 	assert.NotEmpty(out2.String())
 }
 
+type failingWriter struct {
+	fail bool
+}
+
+func (fw *failingWriter) Write(contents []byte) (int, error) {
+	if fw.fail {
+		return 0, fmt.Errorf("disk full")
+	}
+	return len(contents), nil
+}
+
+func TestLoggerWriteErrorFallback(t *testing.T) {
+	assert := assert.New(t)
+
+	output := &failingWriter{fail: true}
+	out := bytes.NewBuffer(nil)
+	notices := bytes.NewBuffer(nil)
+
+	log := None().WithEnabled(Info).
+		WithWriter(NewTextWriter(output)).
+		WithWriter(NewTextWriter(out)).
+		WithWriteErrorOutput(notices).
+		WithWriteErrorNoticeInterval(time.Hour)
+
+	log.SyncInfof("this is a %s", "test")
+	log.SyncInfof("this is a %s", "test")
+	log.SyncInfof("this is a %s", "test")
+
+	assert.Equal(3, log.WriteErrors())
+	assert.Equal(3, bytes.Count(out.Bytes(), []byte("this is a test")), "the other writers should still be written to")
+	assert.Equal(1, bytes.Count(notices.Bytes(), []byte("\n")), "notices should be rate limited")
+	assert.Contains(notices.String(), "failed to write info event (1 consecutive write errors): disk full")
+
+	output.fail = false
+	log.SyncInfof("this is a %s", "test")
+	assert.Zero(log.WriteErrors())
+
+	notices.Reset()
+	output.fail = true
+	log.WithWriteErrorNoticeInterval(time.Nanosecond)
+	log.SyncInfof("this is a %s", "test")
+	time.Sleep(time.Millisecond)
+	log.SyncInfof("this is a %s", "test")
+	assert.Equal(2, bytes.Count(notices.Bytes(), []byte("\n")))
+	assert.Contains(notices.String(), "(2 consecutive write errors)")
+}
+
+func TestLoggerWriteErrorsMultipleWriters(t *testing.T) {
+	assert := assert.New(t)
+
+	notices := bytes.NewBuffer(nil)
+	log := None().WithEnabled(Info).
+		WithWriter(NewTextWriter(&failingWriter{fail: true})).
+		WithWriter(NewTextWriter(&failingWriter{fail: true})).
+		WithWriteErrorOutput(notices).
+		WithWriteErrorNoticeInterval(time.Hour)
+
+	log.SyncInfof("this is a %s", "test")
+	log.SyncInfof("this is a %s", "test")
+	assert.Equal(2, log.WriteErrors(), "write errors should count failed writes, not failing writers")
+	assert.Contains(notices.String(), "(1 consecutive write errors)")
+}
+
 type panics struct {
 	didRun bool
 }
@@ -807,3 +939,301 @@ func TestLoggerRecoverPanics(t *testing.T) {
 		all.SyncTrigger(Messagef(Info, "this is only a test"))
 	})
 }
+
+func TestLoggerWithLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	defaults := map[string]string{"service": "foo", "env": "test"}
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewJSONWriter(buffer)).WithLabels(defaults)
+	defer log.Close()
+
+	defaults["service"] = "changed"
+	assert.Equal("foo", log.Labels()["service"])
+
+	var labels map[string]string
+	log.Listen(Info, "labels", func(e Event) {
+		labels = e.(EventLabels).Labels()
+	})
+
+	first := Messagef(Info, "first")
+	first.AddLabelValue("env", "prod")
+	log.SyncTrigger(first)
+	assert.Equal(map[string]string{"service": "foo", "env": "prod"}, labels)
+	assert.Contains(buffer.String(), `"env":"prod"`)
+	assert.Contains(buffer.String(), `"service":"foo"`)
+
+	log.SyncTrigger(Messagef(Info, "second"))
+	assert.Equal(map[string]string{"service": "foo", "env": "test"}, labels)
+	assert.Equal(map[string]string{"service": "foo", "env": "test"}, log.Labels())
+}
+
+func TestLoggerEnrichers(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewJSONWriter(buffer))
+	defer log.Close()
+
+	log.AddEnricher(func(meta EventMeta) EventMeta {
+		meta.AddLabelValue("host", "worker-0")
+		return meta
+	})
+	log.AddEnricher(func(meta EventMeta) EventMeta {
+		meta.AddLabelValue("order", meta.Labels()["host"]+"-second")
+		return meta
+	})
+	assert.Len(log.Enrichers(), 2)
+
+	var events []Event
+	log.Listen(Info, "enriched", func(e Event) { events = append(events, e) })
+	log.Listen(Error, "enriched", func(e Event) { events = append(events, e) })
+
+	log.SyncTrigger(Messagef(Info, "first"))
+	log.SyncTrigger(Errorf(Error, "second"))
+	log.SyncInfof("third")
+
+	assert.Len(events, 3)
+	for _, e := range events {
+		labels := e.(EventLabels).Labels()
+		assert.Equal("worker-0", labels["host"])
+		assert.Equal("worker-0-second", labels["order"], "enrichers should run in registration order")
+	}
+	assert.Equal(3, strings.Count(buffer.String(), `"host":"worker-0"`))
+}
+
+func TestLoggerFatalFlushes(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewTextWriter(buffer).WithUseColor(false)).WithFatalExit(true)
+	defer log.Close()
+
+	var exitContents string
+	log.exit = func(_ int) {
+		exitContents = buffer.String()
+	}
+
+	for x := 0; x < 64; x++ {
+		log.Infof("before %d", x)
+	}
+	log.Fatalf("this is only a test")
+
+	for x := 0; x < 64; x++ {
+		assert.Contains(exitContents, fmt.Sprintf("before %d\n", x))
+	}
+	assert.Contains(exitContents, "this is only a test")
+	assert.True(strings.Index(exitContents, "before 63") < strings.Index(exitContents, "this is only a test"))
+	assert.True(log.isStarted(), "flushing should not stop the logger")
+}
+
+func TestLoggerFatalFlushesWithoutExit(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewTextWriter(buffer).WithUseColor(false))
+	defer log.Close()
+
+	var exited bool
+	log.exit = func(_ int) {
+		exited = true
+	}
+
+	var eventsLock sync.Mutex
+	var events []Flag
+	listener := func(e Event) {
+		eventsLock.Lock()
+		defer eventsLock.Unlock()
+		events = append(events, e.Flag())
+	}
+	log.Listen(Info, "events", listener)
+	log.Listen(Fatal, "events", listener)
+
+	for x := 0; x < 64; x++ {
+		log.Infof("before %d", x)
+	}
+	log.Fatalf("this is only a test")
+
+	contents := buffer.String()
+	for x := 0; x < 64; x++ {
+		assert.Contains(contents, fmt.Sprintf("before %d\n", x))
+	}
+	assert.Contains(contents, "this is only a test", "the fatal event should be written before the flush returns")
+
+	eventsLock.Lock()
+	defer eventsLock.Unlock()
+	assert.Len(events, 65, "listeners should be flushed before the flush returns")
+	assert.Any(events, func(v interface{}) bool { return v.(Flag) == Fatal }, "the fatal event should be sent to listeners")
+	assert.False(exited, "the process should not exit unless fatal exit is enabled")
+	assert.True(log.isStarted())
+}
+
+func TestLoggerFatalFromListener(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewTextWriter(buffer).WithUseColor(false)).WithFatalExit(true).
+		WithFatalFlushTimeout(50 * time.Millisecond).WithWriteErrorOutput(new(bytes.Buffer))
+	defer log.Close()
+
+	exited := make(chan int, 1)
+	log.exit = func(code int) {
+		exited <- code
+	}
+	log.Listen(Info, "fatal", func(_ Event) {
+		log.Fatalf("from a listener")
+	})
+	log.Infof("this is only a test")
+
+	select {
+	case code := <-exited:
+		assert.Equal(1, code)
+	case <-time.After(time.Second):
+		assert.FailNow("a fatal event from a listener should exit after the flush timeout")
+	}
+}
+
+func TestSubContextFatalExit(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewTextWriter(buffer).WithUseColor(false)).WithFatalExit(true)
+	defer log.Close()
+
+	var exitCode int
+	var exitContents string
+	log.exit = func(code int) {
+		exitCode = code
+		exitContents = buffer.String()
+	}
+
+	log.SubContext("sub").Fatal(fmt.Errorf("exiting"))
+	assert.Equal(1, exitCode)
+	assert.Contains(exitContents, "exiting")
+}
+
+func TestLoggerFatalExit(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewTextWriter(buffer).WithUseColor(false))
+	defer log.Close()
+
+	var exitCode int
+	var exitContents string
+	log.exit = func(code int) {
+		exitCode = code
+		exitContents = buffer.String()
+	}
+
+	log.Fatal(fmt.Errorf("this is only a test"))
+	assert.Zero(exitCode, "the process should not exit by default")
+
+	log.WithFatalExit(true)
+	log.Infof("before exit")
+	log.Fatal(fmt.Errorf("exiting"))
+	assert.Equal(1, exitCode)
+	assert.Contains(exitContents, "before exit", "output should be flushed before exiting")
+	assert.Contains(exitContents, "exiting")
+}
+
+func TestLoggerSyncFatalExit(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).WithWriter(NewTextWriter(buffer).WithUseColor(false))
+	defer log.Close()
+
+	var events int32
+	log.Listen(Info, "events", func(_ Event) {
+		atomic.AddInt32(&events, 1)
+	})
+
+	var exitCode int
+	var exitEvents int32
+	var exitContents string
+	log.exit = func(code int) {
+		exitCode = code
+		exitEvents = atomic.LoadInt32(&events)
+		exitContents = buffer.String()
+	}
+
+	for x := 0; x < 64; x++ {
+		log.Infof("before %d", x)
+	}
+	log.SyncFatalExit(fmt.Errorf("exiting"))
+	assert.Equal(1, exitCode, "the process should exit even if fatal exit is disabled")
+	assert.Equal(64, exitEvents, "listener events should be flushed before exiting")
+	assert.Contains(exitContents, "before 63")
+	assert.Contains(exitContents, "exiting")
+}
+
+func TestLoggerDrainContext(t *testing.T) {
+	assert := assert.New(t)
+
+	log := New().WithFlags(AllFlags())
+	defer log.Close()
+
+	unblock := make(chan struct{})
+	log.Listen(Info, "blocks", func(_ Event) {
+		<-unblock
+	})
+	log.Infof("this is only a test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(context.DeadlineExceeded, log.DrainContext(ctx))
+
+	close(unblock)
+	assert.Nil(log.DrainContext(context.Background()))
+}
+
+func TestLoggerSequenceNumbers(t *testing.T) {
+	assert := assert.New(t)
+
+	output := bytes.NewBuffer(nil)
+	log := New().WithFlags(AllFlags()).
+		WithWriter(NewTextWriter(output).WithUseColor(false).WithShowTimestamp(false)).
+		WithSequenceNumbers(true)
+	defer log.Close()
+	assert.True(log.SequenceNumbers())
+
+	const goroutines, events = 8, 100
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for x := 0; x < goroutines; x++ {
+		go func(x int) {
+			defer wg.Done()
+			for y := 0; y < events; y++ {
+				log.Infof("goroutine %d event %d", x, y)
+			}
+		}(x)
+	}
+	wg.Wait()
+	assert.Nil(log.Drain())
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Len(lines, goroutines*events)
+	var previous uint64
+	for _, line := range lines {
+		var seq uint64
+		_, err := fmt.Sscanf(line, "#%d ", &seq)
+		assert.Nil(err, line)
+		assert.True(seq > previous, fmt.Sprintf("sequence numbers should be strictly increasing, %d after %d", seq, previous))
+		previous = seq
+	}
+	assert.Equal(goroutines*events, int(previous))
+}
+
+func TestLoggerSequenceNumbersDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	output := bytes.NewBuffer(nil)
+	log := Sync().WithFlags(AllFlags()).WithWriters(NewTextWriter(output).WithUseColor(false).WithShowTimestamp(false))
+	assert.False(log.SequenceNumbers())
+
+	e := Messagef(Info, "test")
+	log.SyncTrigger(e)
+	assert.Zero(e.Sequence())
+	assert.Equal("[info] test\n", output.String())
+}
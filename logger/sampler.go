@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// NewSampler returns a new sampler.
+// Flags without a sampling rule are not sampled.
+func NewSampler() *Sampler {
+	return &Sampler{
+		rules: make(map[Flag]*SamplerRule),
+	}
+}
+
+// Sampler decides if events should be triggered, per flag, to reduce the volume of high-frequency events.
+// A flag can let through 1 in N events, at most M events per second, or both.
+type Sampler struct {
+	sync.RWMutex
+	rules map[Flag]*SamplerRule
+}
+
+// WithEvery lets through 1 in every N events for a flag.
+func (s *Sampler) WithEvery(flag Flag, every int) *Sampler {
+	s.rule(flag).every = int64(every)
+	return s
+}
+
+// WithPerSecond lets through at most M events per second for a flag.
+func (s *Sampler) WithPerSecond(flag Flag, perSecond int) *Sampler {
+	s.rule(flag).perSecond = int64(perSecond)
+	return s
+}
+
+// Rule returns the sampling rule for a flag, or nil if the flag is not sampled.
+func (s *Sampler) Rule(flag Flag) *SamplerRule {
+	s.RLock()
+	defer s.RUnlock()
+	return s.rules[flag]
+}
+
+// Allow returns if an event with a given flag should be triggered.
+func (s *Sampler) Allow(flag Flag) bool {
+	if rule := s.Rule(flag); rule != nil {
+		return rule.Allow(time.Now().UTC())
+	}
+	return true
+}
+
+func (s *Sampler) rule(flag Flag) *SamplerRule {
+	s.Lock()
+	defer s.Unlock()
+	rule, ok := s.rules[flag]
+	if !ok {
+		rule = &SamplerRule{}
+		s.rules[flag] = rule
+	}
+	return rule
+}
+
+// SamplerRule is the sampling rule for a flag.
+type SamplerRule struct {
+	sync.Mutex
+	every     int64
+	perSecond int64
+
+	count       int64
+	window      time.Time
+	windowCount int64
+}
+
+// Every returns the N in 1 in N events let through.
+func (sr *SamplerRule) Every() int64 { return sr.every }
+
+// PerSecond returns the maximum number of events let through per second.
+func (sr *SamplerRule) PerSecond() int64 { return sr.perSecond }
+
+// Allow returns if an event should be let through at a given time.
+// The per second count resets at the start of each second.
+func (sr *SamplerRule) Allow(now time.Time) bool {
+	if sr.every > 1 {
+		if (atomic.AddInt64(&sr.count, 1)-1)%sr.every != 0 {
+			return false
+		}
+	}
+	if sr.perSecond > 0 {
+		window := now.Truncate(time.Second)
+
+		sr.Lock()
+		defer sr.Unlock()
+
+		if window.After(sr.window) {
+			sr.window = window
+			sr.windowCount = 0
+		}
+		if sr.windowCount >= sr.perSecond {
+			return false
+		}
+		sr.windowCount++
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package logger
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestSamplerEvery(t *testing.T) {
+	assert := assert.New(t)
+
+	sampler := NewSampler().WithEvery(Info, 3)
+	var allowed int
+	for x := 0; x < 9; x++ {
+		if sampler.Allow(Info) {
+			allowed++
+		}
+	}
+	assert.Equal(3, allowed)
+	assert.True(sampler.Allow(Debug), "flags without a rule should not be sampled")
+	assert.Nil(sampler.Rule(Debug))
+	assert.Equal(3, sampler.Rule(Info).Every())
+}
+
+func TestSamplerRulePerSecond(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := NewSampler().WithPerSecond(Info, 2).Rule(Info)
+	assert.Equal(2, rule.PerSecond())
+
+	now := time.Date(2019, 05, 04, 12, 00, 00, 00, time.UTC)
+	assert.True(rule.Allow(now))
+	assert.True(rule.Allow(now.Add(100 * time.Millisecond)))
+	assert.False(rule.Allow(now.Add(200 * time.Millisecond)))
+	assert.False(rule.Allow(now.Add(999 * time.Millisecond)))
+
+	// the window resets on the second boundary
+	assert.True(rule.Allow(now.Add(time.Second)))
+	assert.True(rule.Allow(now.Add(1500 * time.Millisecond)))
+	assert.False(rule.Allow(now.Add(1600 * time.Millisecond)))
+}
+
+func TestSamplerRulePerSecondAligned(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := NewSampler().WithPerSecond(Info, 1).Rule(Info)
+
+	now := time.Date(2019, 05, 04, 12, 00, 00, 00, time.UTC)
+	assert.True(rule.Allow(now.Add(900 * time.Millisecond)))
+	assert.False(rule.Allow(now.Add(950 * time.Millisecond)))
+
+	// the window starts on the second, not when the first event was let through
+	assert.True(rule.Allow(now.Add(time.Second)))
+	assert.False(rule.Allow(now.Add(1899 * time.Millisecond)))
+}
+
+func TestSamplerRulePerSecondConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := NewSampler().WithPerSecond(Info, 10).Rule(Info)
+
+	now := time.Date(2019, 05, 04, 12, 00, 00, 00, time.UTC)
+	allow := func(now time.Time) int64 {
+		var allowed int64
+		var wg sync.WaitGroup
+		for x := 0; x < 8; x++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for y := 0; y < 64; y++ {
+					if rule.Allow(now.Add(time.Duration(y) * time.Millisecond)) {
+						atomic.AddInt64(&allowed, 1)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		return allowed
+	}
+	assert.Equal(10, allow(now), "each window should let through exactly the per second limit")
+	assert.Equal(10, allow(now.Add(time.Second)))
+}
+
+func TestLoggerWithSampler(t *testing.T) {
+	assert := assert.New(t)
+
+	output := bytes.NewBuffer(nil)
+	log := None().WithEnabled(Info, Debug).WithWriter(NewTextWriter(output).WithShowTimestamp(false).WithUseColor(false)).
+		WithSampler(NewSampler().WithEvery(Info, 10))
+	assert.NotNil(log.Sampler())
+
+	for x := 0; x < 99; x++ {
+		log.SyncInfof("sampled %d", x)
+		log.SyncDebugf("not sampled %d", x)
+	}
+	log.SyncTrigger(Messagef(Info, "event"))
+
+	assert.Equal(10, bytes.Count(output.Bytes(), []byte("[info] sampled")))
+	assert.Equal(99, bytes.Count(output.Bytes(), []byte("[debug] not sampled")))
+	assert.Contains(output.String(), "[info] sampled 0\n")
+	assert.Contains(output.String(), "[info] sampled 90\n")
+	assert.NotContains(output.String(), "[info] sampled 1\n")
+	assert.NotContains(output.String(), "[info] event", "events should count towards the sample")
+}
@@ -211,6 +211,7 @@ func (sc *SubContext) Fatalf(format string, args ...interface{}) {
 	sc.injectLabels(msg)
 	sc.injectAnnotations(msg)
 	sc.log.Trigger(msg)
+	sc.log.flushFatal()
 }
 
 // Fatal writes an error message.
@@ -220,6 +221,7 @@ func (sc *SubContext) Fatal(err error) error {
 	sc.injectLabels(msg)
 	sc.injectAnnotations(msg)
 	sc.log.Trigger(msg)
+	sc.log.flushFatal()
 	return err
 }
 
@@ -230,6 +232,7 @@ func (sc *SubContext) SyncFatalf(format string, args ...interface{}) {
 	sc.injectLabels(msg)
 	sc.injectAnnotations(msg)
 	sc.log.SyncTrigger(msg)
+	sc.log.flushFatal()
 }
 
 // SyncFatal writes an error message.
@@ -239,6 +242,7 @@ func (sc *SubContext) SyncFatal(err error) error {
 	sc.injectLabels(msg)
 	sc.injectAnnotations(msg)
 	sc.log.SyncTrigger(msg)
+	sc.log.flushFatal()
 	return err
 }
 
@@ -32,6 +32,9 @@ type TextFormatter interface {
 	ColorizeByStatusCode(code int, value string) string
 }
 
+// TextEventFormatter writes an event to a buffer as a line of text (without the trailing newline).
+type TextEventFormatter func(wr *TextWriter, buf *bytes.Buffer, e Event)
+
 // NewTextWriter returns a new text writer for a given output.
 func NewTextWriter(output io.Writer) *TextWriter {
 	return &TextWriter{
@@ -78,6 +81,9 @@ type TextWriter struct {
 
 	timeFormat string
 
+	defaultFormatter TextEventFormatter
+	flagFormatters   map[Flag]TextEventFormatter
+
 	bufferPool *BufferPool
 }
 
@@ -119,6 +125,33 @@ func (wr *TextWriter) ShowHeadings() bool {
 	return wr.showHeadings
 }
 
+// WithFlagFormatter sets the formatter for events with a given flag.
+func (wr *TextWriter) WithFlagFormatter(flag Flag, formatter TextEventFormatter) *TextWriter {
+	if wr.flagFormatters == nil {
+		wr.flagFormatters = make(map[Flag]TextEventFormatter)
+	}
+	wr.flagFormatters[flag] = formatter
+	return wr
+}
+
+// WithDefaultFormatter sets the formatter for events with flags that don't have a formatter.
+func (wr *TextWriter) WithDefaultFormatter(formatter TextEventFormatter) *TextWriter {
+	wr.defaultFormatter = formatter
+	return wr
+}
+
+// Formatter returns the formatter for a given flag.
+// It falls back to the default formatter, and then to `FormatTextEvent`.
+func (wr *TextWriter) Formatter(flag Flag) TextEventFormatter {
+	if formatter, ok := wr.flagFormatters[flag]; ok && formatter != nil {
+		return formatter
+	}
+	if wr.defaultFormatter != nil {
+		return wr.defaultFormatter
+	}
+	return FormatTextEvent
+}
+
 // WithTimeFormat sets a formatting option.
 func (wr *TextWriter) WithTimeFormat(timeFormat string) *TextWriter {
 	wr.timeFormat = timeFormat
@@ -245,11 +278,26 @@ func (wr *TextWriter) write(output io.Writer, e Event) error {
 	buf := wr.bufferPool.Get()
 	defer wr.bufferPool.Put(buf)
 
+	wr.Formatter(e.Flag())(wr, buf, e)
+
+	buf.WriteRune(RuneNewline)
+	_, err := buf.WriteTo(output)
+	return err
+}
+
+// FormatTextEvent is the default text event formatter.
+// It writes the timestamp, headings, flag and entity (as enabled), followed by the event body.
+func FormatTextEvent(wr *TextWriter, buf *bytes.Buffer, e Event) {
 	if wr.showTimestamp {
 		buf.WriteString(wr.FormatTimestamp(e.Timestamp()))
 		buf.WriteRune(RuneSpace)
 	}
 
+	if typed, isTyped := e.(EventSequence); isTyped && typed.Sequence() > 0 {
+		buf.WriteString(wr.Colorize(fmt.Sprintf("#%d", typed.Sequence()), ColorGray))
+		buf.WriteRune(RuneSpace)
+	}
+
 	if typed, isTyped := e.(EventHeadings); isTyped {
 		headings := typed.Headings()
 		if len(headings) > 0 {
@@ -281,8 +329,4 @@ func (wr *TextWriter) write(output io.Writer, e Event) error {
 	} else if typed, isTyped := e.(fmt.Stringer); isTyped {
 		buf.WriteString(typed.String())
 	}
-
-	buf.WriteRune(RuneNewline)
-	_, err := buf.WriteTo(output)
-	return err
 }
@@ -79,3 +79,32 @@ func TestWriterLabels(t *testing.T) {
 	writer.WriteError(Messagef(Error, "test %s", "string").WithLabel("foo", "bar").WithLabel("moo", "boo"))
 	assert.True(strings.HasPrefix(buffer.String(), "[error] test string"))
 }
+
+func TestWriterFlagFormatters(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	writer := NewTextWriter(buffer).
+		WithShowTimestamp(false).
+		WithUseColor(false).
+		WithFlagFormatter(Info, func(_ *TextWriter, buf *bytes.Buffer, e Event) {
+			buf.WriteString("I " + e.(*MessageEvent).Message())
+		}).
+		WithFlagFormatter(Error, func(wr *TextWriter, buf *bytes.Buffer, e Event) {
+			FormatTextEvent(wr, buf, e)
+			buf.WriteString(" (verbose)")
+		})
+
+	writer.Write(Messagef(Info, "compact"))
+	writer.WriteError(Errorf(Error, "failure"))
+	writer.Write(Messagef(Debug, "default"))
+	assert.Equal("I compact\n[error] failure (verbose)\n[debug] default\n", buffer.String())
+
+	buffer.Reset()
+	writer.WithDefaultFormatter(func(_ *TextWriter, buf *bytes.Buffer, e Event) {
+		buf.WriteString(string(e.Flag()))
+	})
+	writer.Write(Messagef(Debug, "default"))
+	writer.Write(Messagef(Info, "compact"))
+	assert.Equal("debug\nI compact\n", buffer.String())
+}
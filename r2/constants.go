@@ -1,5 +1,7 @@
 package r2
 
+import "time"
+
 const (
 	// MethodGet is a method.
 	MethodGet = "GET"
@@ -16,10 +18,20 @@ const (
 )
 
 const (
+	// HeaderAuthorization is a http header.
+	HeaderAuthorization = "Authorization"
 	// HeaderConnection is a http header.
 	HeaderConnection = "Connection"
+	// HeaderContentEncoding is a http header.
+	HeaderContentEncoding = "Content-Encoding"
 	// HeaderContentType is a http header.
 	HeaderContentType = "Content-Type"
+	// HeaderCookie is a http header.
+	HeaderCookie = "Cookie"
+	// HeaderIdempotencyKey is a http header.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderSetCookie is a http header.
+	HeaderSetCookie = "Set-Cookie"
 )
 
 const (
@@ -37,3 +49,9 @@ const (
 	// ContentTypeApplicationOctetStream is a content type header value.
 	ContentTypeApplicationOctetStream = "application/octet-stream"
 )
+
+const (
+	// DefaultDialKeepAlive is the keep-alive period for connections dialed with `DialTimeout`.
+	// It matches the keep-alive period of `http.DefaultTransport`.
+	DefaultDialKeepAlive = 30 * time.Second
+)
@@ -0,0 +1,10 @@
+package r2
+
+import "github.com/blend/go-sdk/exception"
+
+const (
+	// ErrChecksumAlgorithmUnknown is returned when a checksum algorithm isn't supported.
+	ErrChecksumAlgorithmUnknown exception.Class = "r2: checksum algorithm unknown"
+	// ErrChecksumMismatch is returned when a response body doesn't match an expected checksum.
+	ErrChecksumMismatch exception.Class = "r2: checksum mismatch"
+)
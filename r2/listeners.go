@@ -0,0 +1,15 @@
+package r2
+
+import (
+	"net/http"
+	"time"
+)
+
+// OnRequestListener is a listener called before a request is sent.
+// Returning an error fails the request with that error.
+type OnRequestListener func(req *http.Request) error
+
+// OnResponseListener is a listener called after a request is sent.
+// It is passed the response (or the error) and when the request started.
+// Returning an error fails the request with that error.
+type OnResponseListener func(req *http.Request, res *http.Response, started time.Time, err error) error
@@ -0,0 +1,13 @@
+package r2
+
+import "net/http"
+
+// BasicAuth sets the `Authorization` header to use http basic auth with a given username and password.
+func BasicAuth(username, password string) Option {
+	return func(r *Request) {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		r.SetBasicAuth(username, password)
+	}
+}
@@ -0,0 +1,32 @@
+package r2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestBasicAuth(t *testing.T) {
+	assert := assert.New(t)
+
+	received := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received <- req
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	r := New(server.URL, Header("X-Foo", "bar"), BasicAuth("user", "p@ss:word"))
+	assert.Nil(r.Err)
+	assert.Nil(r.Discard())
+
+	req := <-received
+	assert.Equal("Basic dXNlcjpwQHNzOndvcmQ=", req.Header.Get(HeaderAuthorization))
+	username, password, ok := req.BasicAuth()
+	assert.True(ok)
+	assert.Equal("user", username)
+	assert.Equal("p@ss:word", password)
+	assert.Equal("bar", req.Header.Get("X-Foo"), "other headers should be kept")
+}
@@ -0,0 +1,13 @@
+package r2
+
+import "net/http"
+
+// BearerToken sets the `Authorization` header to `Bearer <token>`.
+func BearerToken(token string) Option {
+	return func(r *Request) {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		r.Header.Set(HeaderAuthorization, "Bearer "+token)
+	}
+}
@@ -0,0 +1,28 @@
+package r2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestBearerToken(t *testing.T) {
+	assert := assert.New(t)
+
+	received := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received <- req
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	r := New(server.URL, BearerToken("secret-token"), Header("X-Foo", "bar"))
+	assert.Nil(r.Err)
+	assert.Nil(r.Discard())
+
+	req := <-received
+	assert.Equal("Bearer secret-token", req.Header.Get(HeaderAuthorization))
+	assert.Equal("bar", req.Header.Get("X-Foo"), "other headers should be kept")
+}
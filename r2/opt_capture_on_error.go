@@ -0,0 +1,77 @@
+package r2
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/blend/go-sdk/exception"
+)
+
+// CaptureOnError stores the raw response body into `out` if the response status is not 2xx,
+// or if decoding the response with `JSON` or `XML` fails; otherwise `out` is left nil.
+// The body can still be read or decoded as normal.
+func CaptureOnError(out *[]byte) Option {
+	return func(r *Request) {
+		r.OnResponse = append(r.OnResponse, func(_ *http.Request, res *http.Response, _ time.Time, err error) error {
+			*out = nil
+			if err != nil || res == nil || res.Body == nil {
+				return nil
+			}
+			if res.StatusCode < http.StatusOK || res.StatusCode > 299 {
+				contents, err := ioutil.ReadAll(res.Body)
+				if err != nil {
+					return exception.New(err)
+				}
+				*out = contents
+				res.Body = &capturedBody{Reader: bytes.NewReader(contents), Closer: res.Body}
+				return nil
+			}
+			res.Body = &captureReader{ReadCloser: res.Body, Out: out}
+			return nil
+		})
+	}
+}
+
+// capturedBody is a response body that has already been read into memory.
+type capturedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// captureReader buffers the contents read through it so they can be
+// captured if decoding them fails.
+type captureReader struct {
+	io.ReadCloser
+	Out    *[]byte
+	Buffer bytes.Buffer
+}
+
+// Read implements io.Reader.
+func (cr *captureReader) Read(p []byte) (n int, err error) {
+	n, err = cr.ReadCloser.Read(p)
+	if n > 0 {
+		cr.Buffer.Write(p[:n])
+	}
+	return
+}
+
+// onDecodeError captures the contents read so far, as well as any remaining contents.
+func (cr *captureReader) onDecodeError() {
+	io.Copy(&cr.Buffer, cr.ReadCloser)
+	*cr.Out = cr.Buffer.Bytes()
+}
+
+// decodeErrorReceiver is a response body that is notified when decoding it fails.
+type decodeErrorReceiver interface {
+	onDecodeError()
+}
+
+// notifyDecodeError notifies the response body that decoding it failed.
+func notifyDecodeError(body io.Reader) {
+	if typed, ok := body.(decodeErrorReceiver); ok {
+		typed.onDecodeError()
+	}
+}
@@ -0,0 +1,44 @@
+package r2
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestCaptureOnError(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/error":
+			rw.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(rw, `{"status":"error"}`)
+		case "/invalid":
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprint(rw, `{"status":`)
+		default:
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprint(rw, `{"status":"ok"}`)
+		}
+	}))
+	defer server.Close()
+
+	var captured []byte
+	var response struct {
+		Status string `json:"status"`
+	}
+	assert.Nil(New(server.URL+"/error", CaptureOnError(&captured)).JSON(&response))
+	assert.Equal(`{"status":"error"}`, string(captured))
+	assert.Equal("error", response.Status, "the body should still be decoded")
+
+	assert.Nil(New(server.URL, CaptureOnError(&captured)).JSON(&response))
+	assert.Nil(captured)
+	assert.Equal("ok", response.Status)
+
+	assert.NotNil(New(server.URL+"/invalid", CaptureOnError(&captured)).JSON(&response))
+	assert.Equal(`{"status":`, string(captured))
+}
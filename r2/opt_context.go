@@ -4,9 +4,14 @@ import (
 	"context"
 )
 
-// Context sets the request context.
+// Context sets the request context, which can be cancelled to abort the request.
+// If the client also has a `Timeout`, the request is aborted by whichever is earlier,
+// the context deadline (or cancellation) or the timeout.
 func Context(ctx context.Context) Option {
 	return func(r *Request) {
+		if r.Request == nil {
+			return
+		}
 		r.Request = r.Request.WithContext(ctx)
 	}
 }
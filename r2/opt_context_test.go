@@ -0,0 +1,69 @@
+package r2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestContext(t *testing.T) {
+	assert := assert.New(t)
+
+	received := make(chan struct{})
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		close(received)
+		select {
+		case <-done:
+		case <-req.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := New(server.URL, Context(ctx))
+	assert.Equal(ctx, r.Request.Context())
+
+	go func() {
+		<-received
+		cancel()
+	}()
+	err := r.Discard()
+	assert.NotNil(err)
+	assert.Equal(context.Canceled, ctx.Err())
+	assert.Contains(err.Error(), context.Canceled.Error())
+}
+
+func TestContextDeadlineBeforeTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-done:
+		case <-req.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	err := New(server.URL, Context(ctx), Timeout(time.Minute)).Discard()
+	assert.NotNil(err)
+	assert.True(time.Since(started) < time.Minute, "the context deadline should have aborted the request")
+}
+
+func TestContextInvalidURL(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New(string([]byte{0x7f}), Context(context.Background()))
+	assert.NotNil(r.Err)
+}
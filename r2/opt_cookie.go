@@ -3,15 +3,17 @@ package r2
 import "net/http"
 
 // Cookie adds a cookie.
+// Multiple cookies accumulate on the request.
 func Cookie(cookie *http.Cookie) Option {
 	return func(r *Request) {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
 		r.AddCookie(cookie)
 	}
 }
 
 // CookieValue adds a cookie with a given name and value.
 func CookieValue(name, value string) Option {
-	return func(r *Request) {
-		r.AddCookie(&http.Cookie{Name: name, Value: value})
-	}
+	return Cookie(&http.Cookie{Name: name, Value: value})
 }
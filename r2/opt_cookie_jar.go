@@ -0,0 +1,14 @@
+package r2
+
+import "net/http"
+
+// CookieJar sets the cookie jar on the client.
+// It will create a client if unset.
+func CookieJar(jar http.CookieJar) Option {
+	return func(r *Request) {
+		if r.Client == nil {
+			r.Client = &http.Client{}
+		}
+		r.Client.Jar = jar
+	}
+}
@@ -0,0 +1,65 @@
+package r2
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestCookie(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost/foo",
+		Cookie(&http.Cookie{Name: "foo", Value: "bar"}),
+		Cookie(&http.Cookie{Name: "buzz", Value: "fuzz"}),
+		CookieValue("ssid", "baileydog01"),
+	)
+	assert.Nil(r.Err)
+
+	cookies := r.Cookies()
+	assert.Len(cookies, 3)
+	assert.Equal("foo", cookies[0].Name)
+	assert.Equal("bar", cookies[0].Value)
+	assert.Equal("buzz", cookies[1].Name)
+	assert.Equal("fuzz", cookies[1].Value)
+	assert.Equal("ssid", cookies[2].Name)
+	assert.Equal("baileydog01", cookies[2].Value)
+}
+
+func TestCookieJar(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/login" {
+			http.SetCookie(rw, &http.Cookie{Name: "session", Value: "the-session-id", Path: "/"})
+			rw.WriteHeader(http.StatusOK)
+			return
+		}
+		cookie, err := req.Cookie("session")
+		if err != nil {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprint(rw, cookie.Value)
+	}))
+	defer server.Close()
+
+	jar, err := cookiejar.New(nil)
+	assert.Nil(err)
+
+	assert.Nil(New(server.URL+"/login", CookieJar(jar)).Discard())
+
+	res, err := New(server.URL+"/session", CookieJar(jar)).Do()
+	assert.Nil(err)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode)
+
+	contents, err := New(server.URL+"/session", CookieJar(jar)).Bytes()
+	assert.Nil(err)
+	assert.Equal("the-session-id", string(contents))
+}
@@ -0,0 +1,27 @@
+package r2
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// DialTimeout sets the timeout for establishing connections on the client transport,
+// separately from the overall request timeout.
+// It will create a client, and a transport if unset, and wraps the transport's `DialContext`;
+// if the transport doesn't set one, connections are dialed with a `DefaultDialKeepAlive` keep-alive period.
+func DialTimeout(d time.Duration) Option {
+	return func(r *Request) {
+		if typed := httpTransport(r); typed != nil {
+			dial := typed.DialContext
+			if dial == nil {
+				dial = (&net.Dialer{KeepAlive: DefaultDialKeepAlive}).DialContext
+			}
+			typed.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+				dialCtx, cancel := context.WithTimeout(ctx, d)
+				defer cancel()
+				return dial(dialCtx, network, addr)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package r2
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestDialTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost", DialTimeout(50*time.Millisecond))
+	assert.Nil(r.Err)
+	assert.NotNil(r.Client)
+	transport, ok := r.Client.Transport.(*http.Transport)
+	assert.True(ok)
+	assert.NotNil(transport.DialContext)
+}
+
+func TestDialTimeoutBlockingDial(t *testing.T) {
+	assert := assert.New(t)
+
+	// the dial blocks until its context is done, like a dial to an unresponsive host.
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+	r := New("http://localhost", Transport(transport), DialTimeout(50*time.Millisecond))
+	assert.Nil(r.Err)
+
+	started := time.Now()
+	_, err := r.Do()
+	assert.NotNil(err)
+	typed, ok := err.(net.Error)
+	assert.True(ok)
+	assert.True(typed.Timeout(), "the dial should time out")
+	assert.True(time.Since(started) < 5*time.Second)
+}
+
+func TestTLSHandshakeTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("https://localhost", DialTimeout(time.Second), TLSHandshakeTimeout(5*time.Second))
+	assert.Nil(r.Err)
+	transport, ok := r.Client.Transport.(*http.Transport)
+	assert.True(ok)
+	assert.Equal(5*time.Second, transport.TLSHandshakeTimeout)
+	assert.NotNil(transport.DialContext, "the transport should be shared between options")
+}
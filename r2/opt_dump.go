@@ -0,0 +1,66 @@
+package r2
+
+import (
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"time"
+
+	"github.com/blend/go-sdk/exception"
+)
+
+// DumpRequest writes the full outgoing request, as it would be sent on the wire, to a given writer before it is sent.
+// Bodies are only included if `includeBody` is set.
+// The `Authorization` and `Cookie` header values are redacted, as well as any additional header names provided.
+func DumpRequest(output io.Writer, includeBody bool, redactedHeaders ...string) Option {
+	return func(r *Request) {
+		redacted := append(append([]string{}, DefaultRedactedHeaders...), redactedHeaders...)
+		r.OnRequest = append(r.OnRequest, func(req *http.Request) error {
+			dumped := redactRequest(req, redacted)
+			contents, err := httputil.DumpRequestOut(dumped, includeBody)
+			if err != nil {
+				return exception.New(err)
+			}
+			// dumping the body reads it, and replaces it on the copy only.
+			req.Body = dumped.Body
+			_, err = output.Write(contents)
+			return exception.New(err)
+		})
+	}
+}
+
+// DumpResponse writes the full response to a given writer after it is received.
+// Bodies are only included if `includeBody` is set.
+// The `Set-Cookie` header values are redacted, as well as any additional header names provided.
+func DumpResponse(output io.Writer, includeBody bool, redactedHeaders ...string) Option {
+	return func(r *Request) {
+		redacted := append([]string{HeaderSetCookie}, redactedHeaders...)
+		r.OnResponse = append(r.OnResponse, func(_ *http.Request, res *http.Response, _ time.Time, err error) error {
+			if err != nil || res == nil {
+				return nil
+			}
+			dumped := redactResponse(res, redacted)
+			contents, err := httputil.DumpResponse(dumped, includeBody)
+			if err != nil {
+				return exception.New(err)
+			}
+			res.Body = dumped.Body
+			_, err = output.Write(contents)
+			return exception.New(err)
+		})
+	}
+}
+
+// redactResponse returns a shallow copy of a response with the values of the given headers redacted.
+func redactResponse(res *http.Response, redactedHeaders []string) *http.Response {
+	output := *res
+	output.Header = http.Header{}
+	for key, values := range res.Header {
+		if isRedactedHeader(key, redactedHeaders) {
+			output.Header[key] = []string{RedactedHeaderValue}
+			continue
+		}
+		output.Header[key] = values
+	}
+	return &output
+}
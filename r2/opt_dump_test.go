@@ -0,0 +1,91 @@
+package r2
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestDumpRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		received = string(body)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	output := bytes.NewBuffer(nil)
+	err := New(server.URL+"/foo?bar=baz",
+		Post(),
+		HeaderValue("X-Test", "test-value"),
+		HeaderValue("X-Secret", "secret-value"),
+		HeaderValue(HeaderAuthorization, "Bearer token"),
+		Body(ioutil.NopCloser(strings.NewReader("this is only a test"))),
+		DumpRequest(output, true, "X-Secret"),
+	).Discard()
+	assert.Nil(err)
+
+	dump := output.String()
+	assert.Contains(dump, "POST /foo?bar=baz HTTP/1.1")
+	assert.Contains(dump, "Host: "+strings.TrimPrefix(server.URL, "http://"))
+	assert.Contains(dump, "X-Test: test-value")
+	assert.Contains(dump, "X-Secret: "+RedactedHeaderValue)
+	assert.Contains(dump, "Authorization: "+RedactedHeaderValue)
+	assert.NotContains(dump, "secret-value")
+	assert.Contains(dump, "this is only a test")
+	assert.Equal("this is only a test", received, "the body should still be sent")
+
+	output = bytes.NewBuffer(nil)
+	err = New(server.URL,
+		Post(),
+		Body(ioutil.NopCloser(strings.NewReader("this is only a test"))),
+		DumpRequest(output, false),
+	).Discard()
+	assert.Nil(err)
+	assert.Contains(output.String(), "POST / HTTP/1.1")
+	assert.NotContains(output.String(), "this is only a test")
+	assert.Equal("this is only a test", received)
+}
+
+func TestDumpResponse(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-Test", "test-value")
+		rw.Header().Set("X-Secret", "secret-value")
+		http.SetCookie(rw, &http.Cookie{Name: "session", Value: "secret-session"})
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprint(rw, "this is only a test")
+	}))
+	defer server.Close()
+
+	output := bytes.NewBuffer(nil)
+	body, err := New(server.URL, DumpResponse(output, true, "X-Secret")).Bytes()
+	assert.Nil(err)
+	assert.Equal("this is only a test", string(body), "the body should still be readable")
+
+	dump := output.String()
+	assert.Contains(dump, "HTTP/1.1 200 OK")
+	assert.Contains(dump, "X-Test: test-value")
+	assert.Contains(dump, "X-Secret: "+RedactedHeaderValue)
+	assert.Contains(dump, "Set-Cookie: "+RedactedHeaderValue)
+	assert.NotContains(dump, "secret-value")
+	assert.NotContains(dump, "secret-session")
+	assert.Contains(dump, "this is only a test")
+
+	output = bytes.NewBuffer(nil)
+	body, err = New(server.URL, DumpResponse(output, false)).Bytes()
+	assert.Nil(err)
+	assert.Equal("this is only a test", string(body))
+	assert.Contains(output.String(), "HTTP/1.1 200 OK")
+	assert.NotContains(output.String(), "this is only a test")
+}
@@ -0,0 +1,78 @@
+package r2
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/blend/go-sdk/exception"
+)
+
+// Checksum algorithms.
+const (
+	ChecksumSHA256 = "sha256"
+	ChecksumMD5    = "md5"
+)
+
+// ExpectChecksum verifies the response body against a hex encoded checksum.
+// The digest is computed as the body is read, and the read that would return `io.EOF`
+// instead returns an error if the checksum doesn't match.
+// Supported algorithms are `sha256` and `md5`.
+func ExpectChecksum(algo string, hexsum string) Option {
+	return func(r *Request) {
+		if _, err := newChecksumHash(algo); err != nil {
+			r.Err = err
+			return
+		}
+		r.OnResponse = append(r.OnResponse, func(_ *http.Request, res *http.Response, _ time.Time, err error) error {
+			if err != nil || res == nil || res.Body == nil {
+				return nil
+			}
+			hash, _ := newChecksumHash(algo)
+			res.Body = &checksumReader{
+				ReadCloser: res.Body,
+				Hash:       hash,
+				Expected:   strings.ToLower(hexsum),
+			}
+			return nil
+		})
+	}
+}
+
+func newChecksumHash(algo string) (hash.Hash, error) {
+	switch strings.ToLower(algo) {
+	case ChecksumSHA256:
+		return sha256.New(), nil
+	case ChecksumMD5:
+		return md5.New(), nil
+	default:
+		return nil, exception.New(ErrChecksumAlgorithmUnknown).WithMessagef("algorithm: %s", algo)
+	}
+}
+
+// checksumReader computes a digest of the contents read through it
+// and verifies it when the underlying reader is exhausted.
+type checksumReader struct {
+	io.ReadCloser
+	Hash     hash.Hash
+	Expected string
+}
+
+// Read implements io.Reader.
+func (cr *checksumReader) Read(p []byte) (n int, err error) {
+	n, err = cr.ReadCloser.Read(p)
+	if n > 0 {
+		cr.Hash.Write(p[:n])
+	}
+	if err == io.EOF {
+		if actual := hex.EncodeToString(cr.Hash.Sum(nil)); actual != cr.Expected {
+			return n, exception.New(ErrChecksumMismatch).WithMessagef("expected: %s, actual: %s", cr.Expected, actual)
+		}
+	}
+	return
+}
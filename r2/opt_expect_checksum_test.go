@@ -0,0 +1,43 @@
+package r2
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/exception"
+)
+
+func TestExpectChecksum(t *testing.T) {
+	assert := assert.New(t)
+
+	contents := []byte("this is only a test")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprint(rw, string(contents))
+	}))
+	defer server.Close()
+
+	sha256sum := sha256.Sum256(contents)
+	body, err := New(server.URL, ExpectChecksum(ChecksumSHA256, hex.EncodeToString(sha256sum[:]))).Bytes()
+	assert.Nil(err)
+	assert.Equal(contents, body)
+
+	md5sum := md5.Sum(contents)
+	body, err = New(server.URL, ExpectChecksum(ChecksumMD5, hex.EncodeToString(md5sum[:]))).Bytes()
+	assert.Nil(err)
+	assert.Equal(contents, body)
+
+	wrongsum := sha256.Sum256([]byte("not the contents"))
+	err = New(server.URL, ExpectChecksum(ChecksumSHA256, hex.EncodeToString(wrongsum[:]))).Discard()
+	assert.NotNil(err)
+	assert.True(exception.Is(err, ErrChecksumMismatch))
+
+	err = New(server.URL, ExpectChecksum("crc32", "00000000")).Discard()
+	assert.True(exception.Is(err, ErrChecksumAlgorithmUnknown))
+}
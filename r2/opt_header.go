@@ -4,15 +4,18 @@ import (
 	"net/http"
 )
 
-// Headers sets the request headers.
-func Headers(headers http.Header) Option {
+// Header adds a header value, appending to any existing values for the key.
+func Header(key, value string) Option {
 	return func(r *Request) {
-		r.Header = headers
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		r.Header.Add(key, value)
 	}
 }
 
-// HeaderValue adds or sets a header value.
-func HeaderValue(key, value string) Option {
+// HeaderSet sets a header value, replacing any existing values for the key.
+func HeaderSet(key, value string) Option {
 	return func(r *Request) {
 		if r.Header == nil {
 			r.Header = http.Header{}
@@ -20,3 +23,24 @@ func HeaderValue(key, value string) Option {
 		r.Header.Set(key, value)
 	}
 }
+
+// Headers adds the values of a given set of headers to the request headers,
+// appending to any existing values for each key.
+func Headers(headers http.Header) Option {
+	return func(r *Request) {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		for key, values := range headers {
+			for _, value := range values {
+				r.Header.Add(key, value)
+			}
+		}
+	}
+}
+
+// HeaderValue sets a header value, replacing any existing values for the key.
+// It is equivalent to `HeaderSet`.
+func HeaderValue(key, value string) Option {
+	return HeaderSet(key, value)
+}
@@ -0,0 +1,52 @@
+package r2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	received := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received <- req.Header
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	err := New(server.URL,
+		Header("X-Multi", "one"),
+		Header("X-Multi", "two"),
+		Headers(http.Header{"X-Multi": []string{"three"}, "X-Merged": []string{"merged"}}),
+		HeaderSet("X-Set", "first"),
+		HeaderSet("X-Set", "second"),
+		HeaderValue("X-Value", "value"),
+	).Discard()
+	assert.Nil(err)
+
+	headers := <-received
+	assert.Equal([]string{"one", "two", "three"}, headers["X-Multi"])
+	assert.Equal("merged", headers.Get("X-Merged"))
+	assert.Equal([]string{"second"}, headers["X-Set"])
+	assert.Equal("value", headers.Get("X-Value"))
+}
+
+func TestHeadersInitializesHeader(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &Request{Request: &http.Request{}}
+	r.WithOptions(Header("X-Test", "test"))
+	assert.Equal("test", r.Header.Get("X-Test"))
+
+	r = &Request{Request: &http.Request{}}
+	r.WithOptions(Headers(http.Header{"X-Test": []string{"test"}}))
+	assert.Equal("test", r.Header.Get("X-Test"))
+
+	r = &Request{Request: &http.Request{}}
+	r.WithOptions(HeaderSet("X-Test", "test"))
+	assert.Equal("test", r.Header.Get("X-Test"))
+}
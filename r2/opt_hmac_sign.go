@@ -0,0 +1,84 @@
+package r2
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// HMACSigner returns a signature for a request given a secret.
+// The request body can be read by the signer; it is reset after the signer returns.
+type HMACSigner func(req *http.Request, secret []byte) (string, error)
+
+// HMACSign signs the request when it is sent, setting the signature in a given header.
+// The body is buffered so it can be read by the signer and still be sent (and replayed on redirects).
+// If the signer is nil, `HMACSHA256Signer` is used.
+func HMACSign(secret []byte, signer HMACSigner, headerName string) Option {
+	if signer == nil {
+		signer = HMACSHA256Signer
+	}
+	return func(r *Request) {
+		r.OnRequest = append(r.OnRequest, func(req *http.Request) error {
+			body, err := bufferBody(req)
+			if err != nil {
+				return err
+			}
+			signature, err := signer(req, secret)
+			if err != nil {
+				return err
+			}
+			resetBody(req, body)
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			req.Header.Set(headerName, signature)
+			return nil
+		})
+	}
+}
+
+// HMACSHA256Signer is a signer that computes the hex encoded HMAC-SHA256 of
+// the request method, path and body, separated by newlines.
+func HMACSHA256Signer(req *http.Request, secret []byte) (string, error) {
+	mac := hmac.New(sha256.New, secret)
+	io.WriteString(mac, req.Method)
+	io.WriteString(mac, "\n")
+	io.WriteString(mac, req.URL.Path)
+	io.WriteString(mac, "\n")
+	if req.Body != nil {
+		if _, err := io.Copy(mac, req.Body); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// bufferBody reads the request body into memory and makes it replayable.
+func bufferBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	resetBody(req, body)
+	return body, nil
+}
+
+// resetBody sets the request body to a reader over given contents.
+func resetBody(req *http.Request, body []byte) {
+	if body == nil {
+		return
+	}
+	req.ContentLength = int64(len(body))
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+}
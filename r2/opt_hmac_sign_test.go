@@ -0,0 +1,53 @@
+package r2
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestHMACSign(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprintf(rw, "%s %s", req.Header.Get("X-Signature"), string(body))
+	}))
+	defer server.Close()
+
+	const expectedSignature = "b9d9918b0dbd9004ddbf65ece069a7dcd0cabe7a2e76656c17fae0c17742f170"
+
+	contents, err := New(server.URL+"/foo",
+		Post(),
+		HMACSign([]byte("super-secret"), nil, "X-Signature"),
+		Body(ioutil.NopCloser(strings.NewReader(`{"hello":"world"}`))),
+	).Bytes()
+	assert.Nil(err)
+	assert.Equal(expectedSignature+` {"hello":"world"}`, string(contents))
+
+	contents, err = New(server.URL+"/foo",
+		Post(),
+		HMACSign([]byte("super-secret"), HMACSHA256Signer, "X-Signature"),
+		Body(ioutil.NopCloser(strings.NewReader(`{"hello":"world"}`))),
+	).Bytes()
+	assert.Nil(err)
+	assert.Equal(expectedSignature+` {"hello":"world"}`, string(contents), "the signature should be stable")
+}
+
+func TestHMACSignSignerError(t *testing.T) {
+	assert := assert.New(t)
+
+	err := New("http://localhost/foo",
+		HMACSign([]byte("super-secret"), func(_ *http.Request, _ []byte) (string, error) {
+			return "", fmt.Errorf("this is only a test")
+		}, "X-Signature"),
+	).Discard()
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "this is only a test")
+}
@@ -0,0 +1,48 @@
+package r2
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"golang.org/x/net/http2"
+)
+
+// EnableHTTP2 configures the client transport to use HTTP/2 for tls connections with servers that support it.
+// It will create a client, and a transport if unset.
+func EnableHTTP2() Option {
+	return func(r *Request) {
+		if typed := httpTransport(r); typed != nil {
+			if _, ok := typed.TLSNextProto[http2.NextProtoTLS]; ok {
+				return
+			}
+			if err := http2.ConfigureTransport(typed); err != nil {
+				r.Err = err
+			}
+		}
+	}
+}
+
+// ForceHTTP1 configures the client transport to only use HTTP/1.1, even with servers that support HTTP/2.
+// It will create a client, and a transport if unset.
+func ForceHTTP1() Option {
+	return func(r *Request) {
+		if typed := httpTransport(r); typed != nil {
+			// a non-nil, empty map disables the transport's automatic HTTP/2 support.
+			typed.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+			if typed.TLSClientConfig != nil {
+				typed.TLSClientConfig = typed.TLSClientConfig.Clone()
+				typed.TLSClientConfig.NextProtos = withoutHTTP2(typed.TLSClientConfig.NextProtos)
+			}
+		}
+	}
+}
+
+// withoutHTTP2 returns the protocols without HTTP/2.
+func withoutHTTP2(protos []string) (output []string) {
+	for _, proto := range protos {
+		if proto != http2.NextProtoTLS {
+			output = append(output, proto)
+		}
+	}
+	return
+}
@@ -0,0 +1,51 @@
+package r2
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestForceHTTP1(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(req.Proto))
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	cfg := &tls.Config{InsecureSkipVerify: true}
+
+	proto, err := New(server.URL, EnableHTTP2(), TLSClientConfig(cfg)).Bytes()
+	assert.Nil(err)
+	assert.Equal("HTTP/2.0", string(proto), "the server should support http/2")
+
+	proto, err = New(server.URL, TLSClientConfig(cfg), ForceHTTP1()).Bytes()
+	assert.Nil(err)
+	assert.Equal("HTTP/1.1", string(proto))
+
+	proto, err = New(server.URL, ForceHTTP1(), TLSClientConfig(cfg)).Bytes()
+	assert.Nil(err)
+	assert.Equal("HTTP/1.1", string(proto))
+
+	proto, err = New(server.URL, EnableHTTP2(), TLSClientConfig(cfg), ForceHTTP1()).Bytes()
+	assert.Nil(err)
+	assert.Equal("HTTP/1.1", string(proto))
+	assert.Empty(cfg.NextProtos, "the given config should not be modified")
+}
+
+func TestEnableHTTP2(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("https://localhost", EnableHTTP2(), EnableHTTP2())
+	assert.Nil(r.Err, "enabling http/2 twice should not error")
+	transport, ok := r.Client.Transport.(*http.Transport)
+	assert.True(ok)
+	assert.NotEmpty(transport.TLSNextProto)
+	assert.Any(transport.TLSClientConfig.NextProtos, func(v interface{}) bool { return v.(string) == "h2" })
+}
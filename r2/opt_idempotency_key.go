@@ -0,0 +1,23 @@
+package r2
+
+import (
+	"net/http"
+
+	"github.com/blend/go-sdk/uuid"
+)
+
+// IdempotencyKey sets the `Idempotency-Key` header so the server can dedupe repeated requests.
+// If the key is empty, a uuid (v4) is generated each time the option is applied.
+// The key is fixed on the request, so sending the same request again reuses it.
+func IdempotencyKey(key string) Option {
+	return func(r *Request) {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		value := key
+		if value == "" {
+			value = uuid.V4().String()
+		}
+		r.Header.Set(HeaderIdempotencyKey, value)
+	}
+}
@@ -0,0 +1,50 @@
+package r2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost/foo", IdempotencyKey("test-key"))
+	assert.Equal("test-key", r.Header.Get(HeaderIdempotencyKey))
+
+	r = New("http://localhost/foo", IdempotencyKey(""))
+	assert.NotEmpty(r.Header.Get(HeaderIdempotencyKey))
+	assert.NotEqual(r.Header.Get(HeaderIdempotencyKey), New("http://localhost/foo", IdempotencyKey("")).Header.Get(HeaderIdempotencyKey))
+}
+
+func TestIdempotencyKeySharedOption(t *testing.T) {
+	assert := assert.New(t)
+
+	opt := IdempotencyKey("")
+	first := New("http://localhost/foo", opt).Header.Get(HeaderIdempotencyKey)
+	second := New("http://localhost/foo", opt).Header.Get(HeaderIdempotencyKey)
+	assert.NotEmpty(first)
+	assert.NotEmpty(second)
+	assert.NotEqual(first, second, "each request should get its own generated key")
+}
+
+func TestIdempotencyKeyResend(t *testing.T) {
+	assert := assert.New(t)
+
+	keys := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		keys <- req.Header.Get(HeaderIdempotencyKey)
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	r := New(server.URL, Post(), IdempotencyKey(""))
+	assert.Nil(r.Discard())
+	assert.Nil(r.Discard())
+
+	first, second := <-keys, <-keys
+	assert.NotEmpty(first)
+	assert.Equal(first, second)
+}
@@ -0,0 +1,61 @@
+package r2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+type jsonBodyObject struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestJSONBody(t *testing.T) {
+	assert := assert.New(t)
+
+	type received struct {
+		ContentType   string
+		ContentLength int64
+		Object        jsonBodyObject
+		Err           error
+	}
+	receivedRequests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var value received
+		value.ContentType = req.Header.Get(HeaderContentType)
+		value.ContentLength = req.ContentLength
+		value.Err = json.NewDecoder(req.Body).Decode(&value.Object)
+		receivedRequests <- value
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	object := jsonBodyObject{ID: 1, Name: "foo"}
+	contents, _ := json.Marshal(object)
+
+	assert.Nil(New(server.URL, Post(), JSONBody(object)).Discard())
+	value := <-receivedRequests
+	assert.Nil(value.Err)
+	assert.Equal(object, value.Object)
+	assert.Equal(ContentTypeApplicationJSON, value.ContentType)
+	assert.Equal(len(contents), value.ContentLength)
+
+	assert.Nil(New(server.URL, Post(), HeaderSet(HeaderContentType, "application/vnd.api+json"), JSONBody(object)).Discard())
+	value = <-receivedRequests
+	assert.Equal("application/vnd.api+json", value.ContentType, "an existing content type should be kept")
+	assert.Equal(object, value.Object)
+}
+
+func TestJSONBodyMarshalError(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost", Post(), JSONBody(map[string]interface{}{"fn": func() {}}))
+	assert.NotNil(r.Err)
+	_, err := r.Do()
+	assert.NotNil(err)
+	assert.Equal(r.Err, err)
+}
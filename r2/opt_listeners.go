@@ -0,0 +1,15 @@
+package r2
+
+// OnResponse adds an on response listener.
+func OnResponse(listener OnResponseListener) Option {
+	return func(r *Request) {
+		r.OnResponse = append(r.OnResponse, listener)
+	}
+}
+
+// OnRequest adds an on request listener.
+func OnRequest(listener OnRequestListener) Option {
+	return func(r *Request) {
+		r.OnRequest = append(r.OnRequest, listener)
+	}
+}
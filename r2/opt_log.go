@@ -0,0 +1,69 @@
+package r2
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/blend/go-sdk/logger"
+)
+
+// DefaultRedactedHeaders are the headers whose values are always redacted by the `Log` option.
+var DefaultRedactedHeaders = []string{
+	HeaderAuthorization,
+	HeaderCookie,
+}
+
+// RedactedHeaderValue is the value that replaces redacted header values.
+const RedactedHeaderValue = "<redacted>"
+
+// Log triggers an `http.request` event before the request is sent, and an `http.response` event
+// (or an error) after it completes, with the time elapsed while the request was being sent.
+// The requests on the events have their `Authorization` and `Cookie` header values redacted,
+// as well as any additional header names provided.
+func Log(log logger.Log, redactedHeaders ...string) Option {
+	return func(r *Request) {
+		redacted := append(append([]string{}, DefaultRedactedHeaders...), redactedHeaders...)
+		r.OnRequest = append(r.OnRequest, func(req *http.Request) error {
+			logger.MaybeTrigger(log, logger.NewHTTPRequestEvent(redactRequest(req, redacted)))
+			return nil
+		})
+		r.OnResponse = append(r.OnResponse, func(req *http.Request, res *http.Response, started time.Time, err error) error {
+			if err != nil {
+				logger.MaybeError(log, err)
+				return nil
+			}
+			logger.MaybeTrigger(log, logger.NewHTTPResponseEvent(redactRequest(req, redacted)).
+				WithStatusCode(res.StatusCode).
+				WithContentLength(int(res.ContentLength)).
+				WithContentType(res.Header.Get(HeaderContentType)).
+				WithContentEncoding(res.Header.Get(HeaderContentEncoding)).
+				WithElapsed(time.Now().UTC().Sub(started)),
+			)
+			return nil
+		})
+	}
+}
+
+// redactRequest returns a shallow copy of a request with the values of the given headers redacted.
+func redactRequest(req *http.Request, redactedHeaders []string) *http.Request {
+	output := *req
+	output.Header = http.Header{}
+	for key, values := range req.Header {
+		if isRedactedHeader(key, redactedHeaders) {
+			output.Header[key] = []string{RedactedHeaderValue}
+			continue
+		}
+		output.Header[key] = values
+	}
+	return &output
+}
+
+func isRedactedHeader(key string, redactedHeaders []string) bool {
+	for _, redacted := range redactedHeaders {
+		if strings.EqualFold(key, redacted) {
+			return true
+		}
+	}
+	return false
+}
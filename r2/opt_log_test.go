@@ -0,0 +1,64 @@
+package r2
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/logger"
+)
+
+func TestLog(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(5 * time.Millisecond)
+		rw.Header().Set(HeaderContentType, ContentTypeApplicationJSON)
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprint(rw, `{"status":"ok"}`)
+	}))
+	defer server.Close()
+
+	log := logger.New(logger.HTTPRequest, logger.HTTPResponse)
+	defer log.Close()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	var requestEvent *logger.HTTPRequestEvent
+	var responseEvent *logger.HTTPResponseEvent
+	log.Listen(logger.HTTPRequest, "test", logger.NewHTTPRequestEventListener(func(e *logger.HTTPRequestEvent) {
+		defer wg.Done()
+		requestEvent = e
+	}))
+	log.Listen(logger.HTTPResponse, "test", logger.NewHTTPResponseEventListener(func(e *logger.HTTPResponseEvent) {
+		defer wg.Done()
+		responseEvent = e
+	}))
+
+	req := New(server.URL,
+		HeaderValue(HeaderAuthorization, "Bearer secret"),
+		HeaderValue("X-Api-Key", "secret"),
+		HeaderValue("X-Request-Id", "request-id"),
+		Log(log, "X-Api-Key"),
+	)
+	assert.Nil(req.Discard())
+	wg.Wait()
+
+	assert.NotNil(requestEvent)
+	assert.Equal(MethodGet, requestEvent.Request().Method)
+	assert.Equal(RedactedHeaderValue, requestEvent.Request().Header.Get(HeaderAuthorization))
+	assert.Equal(RedactedHeaderValue, requestEvent.Request().Header.Get("X-Api-Key"))
+	assert.Equal("request-id", requestEvent.Request().Header.Get("X-Request-Id"))
+	assert.Equal("Bearer secret", req.Header.Get(HeaderAuthorization), "the outgoing request should not be redacted")
+
+	assert.NotNil(responseEvent)
+	assert.Equal(http.StatusOK, responseEvent.StatusCode())
+	assert.Equal(ContentTypeApplicationJSON, responseEvent.ContentType())
+	assert.Equal(len(`{"status":"ok"}`), responseEvent.ContentLength())
+	assert.True(responseEvent.Elapsed() >= 5*time.Millisecond)
+	assert.Equal(RedactedHeaderValue, responseEvent.Request().Header.Get(HeaderAuthorization))
+}
@@ -0,0 +1,29 @@
+package r2
+
+import "net/http"
+
+// MockRoundTripper is a round tripper that returns responses from a function
+// instead of sending requests.
+type MockRoundTripper func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (mrt MockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return mrt(req)
+}
+
+// MockTransport sets the client transport to a round tripper that calls a given function,
+// so requests can be tested with canned responses and without a server, e.g.
+//
+//	r2.New("http://localhost/status", r2.MockTransport(func(req *http.Request) (*http.Response, error) {
+//	    return &http.Response{
+//	        StatusCode: http.StatusOK,
+//	        Header:     http.Header{r2.HeaderContentType: []string{r2.ContentTypeApplicationJSON}},
+//	        Body:       ioutil.NopCloser(strings.NewReader(`{"status":"ok"}`)),
+//	    }, nil
+//	})).JSON(&status)
+//
+// It replaces any existing transport. Options that configure the `*http.Transport`,
+// e.g. `TLSClientConfig`, replace the mock transport if they're applied after it.
+func MockTransport(fn func(*http.Request) (*http.Response, error)) Option {
+	return Transport(MockRoundTripper(fn))
+}
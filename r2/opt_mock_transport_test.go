@@ -0,0 +1,45 @@
+package r2
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func mockJSONResponse(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{HeaderContentType: []string{ContentTypeApplicationJSON}},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"status":"ok","path":"` + req.URL.Path + `"}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestMockTransport(t *testing.T) {
+	assert := assert.New(t)
+
+	var status struct {
+		Status string `json:"status"`
+		Path   string `json:"path"`
+	}
+	assert.Nil(New("http://localhost/status", MockTransport(mockJSONResponse)).JSON(&status))
+	assert.Equal("ok", status.Status)
+	assert.Equal("/status", status.Path)
+}
+
+func TestMockTransportLastWriterWins(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost/status", TLSClientConfig(&tls.Config{}), MockTransport(mockJSONResponse))
+	_, isMock := r.Client.Transport.(MockRoundTripper)
+	assert.True(isMock)
+
+	r = New("http://localhost/status", MockTransport(mockJSONResponse), TLSClientConfig(&tls.Config{}))
+	typed, ok := r.Client.Transport.(*http.Transport)
+	assert.True(ok)
+	assert.NotNil(typed.TLSClientConfig)
+}
@@ -1,31 +1,44 @@
 package r2
 
 import (
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
-// PostForm sets the request post form and the content type.
+// PostForm sets the request post form, encoded as the body, and the content type.
+// It also sets the content length.
 func PostForm(postForm url.Values) Option {
 	return func(r *Request) {
-		if r.Header == nil {
-			r.Header = http.Header{}
-		}
-		r.Header.Set(HeaderContentType, ContentTypeApplicationFormEncoded)
 		r.PostForm = postForm
+		setPostFormBody(r)
 	}
 }
 
-// PostFormValue sets a request post form value.
+// PostFormValue sets a request post form value, and re-encodes the post form as the body.
 func PostFormValue(key, value string) Option {
 	return func(r *Request) {
-		if r.Header == nil {
-			r.Header = http.Header{}
-		}
-		r.Header.Set(HeaderContentType, ContentTypeApplicationFormEncoded)
 		if r.PostForm == nil {
 			r.PostForm = url.Values{}
 		}
 		r.PostForm.Set(key, value)
+		setPostFormBody(r)
+	}
+}
+
+// setPostFormBody encodes the request post form as the body and sets the content type and length.
+// The client doesn't send `PostForm` itself, so it has to be encoded into the body.
+func setPostFormBody(r *Request) {
+	if r.Header == nil {
+		r.Header = http.Header{}
+	}
+	r.Header.Set(HeaderContentType, ContentTypeApplicationFormEncoded)
+	contents := r.PostForm.Encode()
+	r.ContentLength = int64(len(contents))
+	r.Body = ioutil.NopCloser(strings.NewReader(contents))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(contents)), nil
 	}
 }
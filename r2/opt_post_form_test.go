@@ -0,0 +1,50 @@
+package r2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestPostForm(t *testing.T) {
+	assert := assert.New(t)
+
+	type received struct {
+		ContentType   string
+		ContentLength int64
+		Custom        string
+		PostForm      url.Values
+		Err           error
+	}
+	receivedRequests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var value received
+		value.ContentType = req.Header.Get(HeaderContentType)
+		value.ContentLength = req.ContentLength
+		value.Custom = req.Header.Get("X-Custom")
+		value.Err = req.ParseForm()
+		value.PostForm = req.PostForm
+		receivedRequests <- value
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	values := url.Values{"username": []string{"foo"}, "password": []string{"bar baz"}}
+	assert.Nil(New(server.URL, Post(), HeaderSet("X-Custom", "value"), PostForm(values)).Discard())
+	value := <-receivedRequests
+	assert.Nil(value.Err)
+	assert.Equal(ContentTypeApplicationFormEncoded, value.ContentType)
+	assert.Equal(len(values.Encode()), value.ContentLength)
+	assert.Equal("value", value.Custom, "other headers should be kept")
+	assert.Equal("foo", value.PostForm.Get("username"))
+	assert.Equal("bar baz", value.PostForm.Get("password"))
+
+	assert.Nil(New(server.URL, Post(), PostFormValue("username", "foo"), PostFormValue("remember", "true")).Discard())
+	value = <-receivedRequests
+	assert.Nil(value.Err)
+	assert.Equal("foo", value.PostForm.Get("username"))
+	assert.Equal("true", value.PostForm.Get("remember"))
+}
@@ -2,18 +2,26 @@ package r2
 
 import "net/url"
 
-// Query set the fully querystring.
+// Query merges a set of query values into the request url's querystring.
+// Values already present on the url are preserved.
 func Query(query url.Values) Option {
 	return func(r *Request) {
-		r.URL.RawQuery = query.Encode()
+		queryValues := r.URL.Query()
+		for key, values := range query {
+			for _, value := range values {
+				queryValues.Add(key, value)
+			}
+		}
+		r.URL.RawQuery = queryValues.Encode()
 	}
 }
 
-// QueryValue adds or sets a query value.
+// QueryValue adds a query value to the request url's querystring.
+// Values already present on the url, including ones for the same key, are preserved.
 func QueryValue(key, value string) Option {
 	return func(r *Request) {
 		queryValues := r.URL.Query()
-		queryValues.Set(key, value)
+		queryValues.Add(key, value)
 		r.URL.RawQuery = queryValues.Encode()
 	}
 }
@@ -0,0 +1,62 @@
+package r2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestQueryValue(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost/foo?existing=true",
+		QueryValue("foo", "bar"),
+		QueryValue("foo", "baz"),
+		QueryValue("special key", "this & that=other"),
+	)
+	assert.Nil(r.Err)
+
+	values := r.URL.Query()
+	assert.Equal("true", values.Get("existing"))
+	assert.Equal([]string{"bar", "baz"}, values["foo"])
+	assert.Equal("this & that=other", values.Get("special key"))
+	assert.Contains(r.URL.RawQuery, "special+key=this+%26+that%3Dother")
+}
+
+func TestQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost/foo?foo=bar",
+		Query(url.Values{
+			"foo":   []string{"baz", "buzz"},
+			"a & b": []string{"c d"},
+		}),
+	)
+	assert.Nil(r.Err)
+
+	values := r.URL.Query()
+	assert.Equal([]string{"bar", "baz", "buzz"}, values["foo"])
+	assert.Equal("c d", values.Get("a & b"))
+}
+
+func TestQueryValueNoExistingQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	received := make(chan url.Values, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received <- req.URL.Query()
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	r := New(server.URL+"/foo", QueryValue("foo", "bar"), Query(url.Values{"foo": []string{"baz"}}))
+	assert.Nil(r.Err)
+	assert.Equal("foo=bar&foo=baz", r.URL.RawQuery)
+	assert.Nil(r.Discard())
+
+	values := <-received
+	assert.Equal([]string{"bar", "baz"}, values["foo"])
+}
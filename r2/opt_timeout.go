@@ -6,6 +6,7 @@ import (
 )
 
 // Timeout sets the client timeout.
+// It will create a client if unset, and does not change the client transport.
 func Timeout(d time.Duration) Option {
 	return func(r *Request) {
 		if r.Client == nil {
@@ -0,0 +1,50 @@
+package r2
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(done)
+
+	r := New(server.URL, Timeout(10*time.Millisecond))
+	assert.NotNil(r.Client)
+	assert.Equal(10*time.Millisecond, r.Client.Timeout)
+
+	started := time.Now()
+	err := r.Discard()
+	assert.NotNil(err)
+	assert.True(time.Since(started) < time.Second, "the request should have timed out")
+}
+
+func TestTimeoutWithTLSClientConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := &tls.Config{InsecureSkipVerify: true}
+	r := New("https://localhost", TLSClientConfig(cfg), Timeout(time.Second))
+	assert.Equal(time.Second, r.Client.Timeout)
+	transport, ok := r.Client.Transport.(*http.Transport)
+	assert.True(ok)
+	assert.Equal(cfg, transport.TLSClientConfig, "the timeout should not have replaced the transport")
+
+	r = New("https://localhost", Timeout(time.Second), TLSClientConfig(cfg))
+	assert.Equal(time.Second, r.Client.Timeout, "the transport should not have reset the timeout")
+	assert.Equal(cfg, r.Client.Transport.(*http.Transport).TLSClientConfig)
+}
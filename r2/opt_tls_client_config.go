@@ -1,21 +1,17 @@
 package r2
 
-import (
-	"crypto/tls"
-	"net/http"
-)
+import "crypto/tls"
 
 // TLSClientConfig sets the tls config for the request.
 // It will create a client, and a transport if unset.
+// If the config does not set `NextProtos`, the protocols set by `EnableHTTP2` or `ForceHTTP1` are kept.
 func TLSClientConfig(cfg *tls.Config) Option {
 	return func(r *Request) {
-		if r.Client == nil {
-			r.Client = &http.Client{}
-		}
-		if r.Client.Transport == nil {
-			r.Client.Transport = &http.Transport{}
-		}
-		if typed, ok := r.Client.Transport.(*http.Transport); ok {
+		if typed := httpTransport(r); typed != nil {
+			if cfg != nil && len(cfg.NextProtos) == 0 && typed.TLSClientConfig != nil && len(typed.TLSClientConfig.NextProtos) > 0 {
+				cfg = cfg.Clone()
+				cfg.NextProtos = typed.TLSClientConfig.NextProtos
+			}
 			typed.TLSClientConfig = cfg
 		}
 	}
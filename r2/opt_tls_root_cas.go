@@ -3,19 +3,12 @@ package r2
 import (
 	"crypto/tls"
 	"crypto/x509"
-	"net/http"
 )
 
 // TLSRootCAs sets the client tls root ca pool.
 func TLSRootCAs(pool *x509.CertPool) Option {
 	return func(r *Request) {
-		if r.Client == nil {
-			r.Client = &http.Client{}
-		}
-		if r.Client.Transport == nil {
-			r.Client.Transport = &http.Transport{}
-		}
-		if typed, ok := r.Client.Transport.(*http.Transport); ok {
+		if typed := httpTransport(r); typed != nil {
 			if typed.TLSClientConfig == nil {
 				typed.TLSClientConfig = &tls.Config{}
 			}
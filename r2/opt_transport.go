@@ -11,3 +11,18 @@ func Transport(transport http.RoundTripper) Option {
 		r.Client.Transport = transport
 	}
 }
+
+// httpTransport returns the request client's `*http.Transport`, creating the client
+// and the transport if they're unset.
+// A mock transport is replaced with a new transport, so the last transport option applied wins.
+// It returns nil if the client uses a custom round tripper.
+func httpTransport(r *Request) *http.Transport {
+	if r.Client == nil {
+		r.Client = &http.Client{}
+	}
+	if _, isMock := r.Client.Transport.(MockRoundTripper); r.Client.Transport == nil || isMock {
+		r.Client.Transport = &http.Transport{}
+	}
+	typed, _ := r.Client.Transport.(*http.Transport)
+	return typed
+}
@@ -0,0 +1,24 @@
+package r2
+
+import (
+	"context"
+	"net"
+)
+
+// UnixSocket routes the request to a unix domain socket at a given path.
+// It will create a client, and a transport if unset, and sets the transport's `DialContext`.
+//
+// The url should keep the `http` scheme; its host is effectively ignored for dialing
+// (it is still sent as the `Host` header), and its path and query are sent as is, e.g.
+//
+//	r2.New("http://localhost/containers/json", r2.UnixSocket("/var/run/docker.sock"))
+func UnixSocket(socketPath string) Option {
+	return func(r *Request) {
+		if typed := httpTransport(r); typed != nil {
+			typed.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socketPath)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package r2
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestUnixSocket(t *testing.T) {
+	assert := assert.New(t)
+
+	tempDir, err := ioutil.TempDir("", "r2")
+	assert.Nil(err)
+	defer os.RemoveAll(tempDir)
+
+	socketPath := filepath.Join(tempDir, "r2.sock")
+	listener, err := net.Listen("unix", socketPath)
+	assert.Nil(err)
+	defer listener.Close()
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprintf(rw, "%s %s %s", req.URL.Path, req.URL.RawQuery, req.Header.Get("X-Test"))
+		}),
+	}
+	go server.Serve(listener)
+	defer server.Close()
+
+	contents, err := New("http://localhost/foo/bar?buzz=fuzz",
+		UnixSocket(socketPath),
+		HeaderValue("X-Test", "unix"),
+	).Bytes()
+	assert.Nil(err)
+	assert.Equal("/foo/bar buzz=fuzz unix", string(contents))
+}
@@ -3,11 +3,14 @@ package r2
 import (
 	"bytes"
 	"encoding/xml"
+	"io"
 	"io/ioutil"
 	"net/http"
 )
 
-// XMLBody sets the post body on the request.
+// XMLBody marshals an object as xml and sets it as the post body on the request.
+// It sets the content length, and sets the content type to xml unless it is already set.
+// If the object cannot be marshalled, the error is returned when the request is sent.
 func XMLBody(obj interface{}) Option {
 	return func(r *Request) {
 		contents, err := xml.Marshal(obj)
@@ -18,7 +21,13 @@ func XMLBody(obj interface{}) Option {
 		if r.Header == nil {
 			r.Header = http.Header{}
 		}
-		r.Header.Set(HeaderContentType, ContentTypeApplicationXML)
-		r.Body = ioutil.NopCloser(bytes.NewBuffer(contents))
+		if r.Header.Get(HeaderContentType) == "" {
+			r.Header.Set(HeaderContentType, ContentTypeApplicationXML)
+		}
+		r.ContentLength = int64(len(contents))
+		r.Body = ioutil.NopCloser(bytes.NewReader(contents))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(contents)), nil
+		}
 	}
 }
@@ -0,0 +1,63 @@
+package r2
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+type xmlBodyObject struct {
+	XMLName xml.Name `xml:"object"`
+	ID      int      `xml:"id,attr"`
+	Name    string   `xml:"name"`
+}
+
+func TestXMLBody(t *testing.T) {
+	assert := assert.New(t)
+
+	type received struct {
+		ContentType   string
+		ContentLength int64
+		Object        xmlBodyObject
+		Err           error
+	}
+	receivedRequests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var value received
+		value.ContentType = req.Header.Get(HeaderContentType)
+		value.ContentLength = req.ContentLength
+		value.Err = xml.NewDecoder(req.Body).Decode(&value.Object)
+		receivedRequests <- value
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	object := xmlBodyObject{ID: 1, Name: "foo"}
+	contents, _ := xml.Marshal(object)
+
+	assert.Nil(New(server.URL, Post(), XMLBody(object)).Discard())
+	value := <-receivedRequests
+	assert.Nil(value.Err)
+	assert.Equal(object.ID, value.Object.ID)
+	assert.Equal(object.Name, value.Object.Name)
+	assert.Equal(ContentTypeApplicationXML, value.ContentType)
+	assert.Equal(len(contents), value.ContentLength)
+
+	assert.Nil(New(server.URL, Post(), HeaderSet(HeaderContentType, "text/xml; charset=utf-8"), XMLBody(object)).Discard())
+	value = <-receivedRequests
+	assert.Equal("text/xml; charset=utf-8", value.ContentType, "an existing content type should be kept")
+	assert.Equal(object.Name, value.Object.Name)
+}
+
+func TestXMLBodyMarshalError(t *testing.T) {
+	assert := assert.New(t)
+
+	r := New("http://localhost", Post(), XMLBody(map[string]string{"foo": "bar"}))
+	assert.NotNil(r.Err)
+	_, err := r.Do()
+	assert.NotNil(err)
+	assert.Equal(r.Err, err)
+}
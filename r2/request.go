@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/blend/go-sdk/exception"
 )
@@ -24,6 +25,7 @@ func New(remoteURL string, options ...Option) *Request {
 		Request: &http.Request{
 			Method: MethodGet, // default to get
 			URL:    parsedURL,
+			Header: http.Header{},
 		},
 	}
 	return req.WithOptions(options...)
@@ -32,8 +34,10 @@ func New(remoteURL string, options ...Option) *Request {
 // Request is a combination of the http.Request options and the underlying client.
 type Request struct {
 	*http.Request
-	Client *http.Client
-	Err    error
+	Client     *http.Client
+	Err        error
+	OnRequest  []OnRequestListener
+	OnResponse []OnResponseListener
 }
 
 // WithOptions applies a given set of options.
@@ -49,10 +53,28 @@ func (r *Request) Do() (*http.Response, error) {
 	if r.Err != nil {
 		return nil, r.Err
 	}
+
+	for _, listener := range r.OnRequest {
+		if err := listener(r.Request); err != nil {
+			return nil, err
+		}
+	}
+
+	started := time.Now().UTC()
+	client := http.DefaultClient
 	if r.Client != nil {
-		return r.Client.Do(r.Request)
+		client = r.Client
+	}
+	res, err := client.Do(r.Request)
+	for _, listener := range r.OnResponse {
+		if listenerErr := listener(r.Request, res, started, err); listenerErr != nil {
+			if res != nil && res.Body != nil {
+				res.Body.Close()
+			}
+			return nil, listenerErr
+		}
 	}
-	return http.DefaultClient.Do(r.Request)
+	return res, err
 }
 
 // Discard discards the response of a request.
@@ -94,6 +116,7 @@ func (r *Request) JSON(ref interface{}) error {
 	}
 	defer res.Body.Close()
 	if err := json.NewDecoder(res.Body).Decode(ref); err != nil {
+		notifyDecodeError(res.Body)
 		return err
 	}
 	return nil
@@ -107,6 +130,7 @@ func (r *Request) XML(ref interface{}) error {
 	}
 	defer res.Body.Close()
 	if err := xml.NewDecoder(res.Body).Decode(ref); err != nil {
+		notifyDecodeError(res.Body)
 		return err
 	}
 	return nil
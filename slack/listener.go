@@ -0,0 +1,118 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/blend/go-sdk/logger"
+)
+
+const (
+	// ListenerSlack is the slack listener name.
+	ListenerSlack = "slack"
+	// DefaultErrorThrottle is the default interval identical errors are posted at most once within.
+	DefaultErrorThrottle = time.Minute
+	// DefaultErrorLimit is the default number of errors posted at most in total within the throttle interval.
+	DefaultErrorLimit = 10
+)
+
+// AddListeners adds slack listeners that post error and fatal events to the configured webhook.
+// It is a no-op if the config is unset.
+func AddListeners(log logger.Listenable, cfg *Config) {
+	if log == nil || cfg == nil || cfg.IsZero() {
+		return
+	}
+	AddErrorListeners(log, New(cfg), DefaultErrorThrottle, DefaultErrorLimit)
+}
+
+// AddErrorListeners adds listeners that post error and fatal events with a given sender.
+// Identical errors, i.e. with the same flag and message, are posted at most once per `throttle`,
+// and at most `limit` errors in total are posted per `throttle`; a limit of zero or less is unlimited.
+func AddErrorListeners(log logger.Listenable, sender Sender, throttle time.Duration, limit int, options ...MessageOption) {
+	if log == nil || sender == nil {
+		return
+	}
+	throttler := &errorThrottler{
+		interval: throttle,
+		limit:    limit,
+		lastSent: map[string]time.Time{},
+	}
+	listener := logger.NewErrorEventListener(func(ee *logger.ErrorEvent) {
+		message := NewErrorMessage(ee)
+		if !throttler.allow(message.Text, time.Now().UTC()) {
+			return
+		}
+		_ = sender.Send(context.Background(), ApplyMessageOptions(message, options...))
+	})
+	log.Listen(logger.Error, ListenerSlack, listener)
+	log.Listen(logger.Fatal, ListenerSlack, listener)
+}
+
+// NewErrorMessage returns a new message for an error event, with its flag, error and labels.
+func NewErrorMessage(ee *logger.ErrorEvent) Message {
+	message := Message{
+		Text: fmt.Sprintf("%s: %v", ee.Flag(), ee.Err()),
+	}
+	attachment := MessageAttachment{
+		Color: "#ff0000",
+		Text:  fmt.Sprintf("%+v", ee.Err()),
+	}
+	labels := ee.Labels()
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attachment.Fields = append(attachment.Fields, MessageAttachmentField{
+			Title: key,
+			Value: labels[key],
+			Short: true,
+		})
+	}
+	message.Attachments = append(message.Attachments, attachment)
+	return message
+}
+
+// errorThrottler tracks when errors were sent, to drop repeated errors within an interval,
+// and any errors past the limit within an interval.
+type errorThrottler struct {
+	sync.Mutex
+	interval time.Duration
+	limit    int
+	lastSent map[string]time.Time
+	sent     []time.Time
+}
+
+// allow returns if an error should be sent, and if so records that it was sent.
+func (et *errorThrottler) allow(key string, now time.Time) bool {
+	et.Lock()
+	defer et.Unlock()
+
+	for sentKey, sent := range et.lastSent {
+		if now.Sub(sent) >= et.interval {
+			delete(et.lastSent, sentKey)
+		}
+	}
+	if _, ok := et.lastSent[key]; ok {
+		return false
+	}
+
+	var recent []time.Time
+	for _, sent := range et.sent {
+		if now.Sub(sent) < et.interval {
+			recent = append(recent, sent)
+		}
+	}
+	et.sent = recent
+	if et.limit > 0 && len(et.sent) >= et.limit {
+		return false
+	}
+
+	et.lastSent[key] = now
+	et.sent = append(et.sent, now)
+	return true
+}
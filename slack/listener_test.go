@@ -0,0 +1,86 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/logger"
+)
+
+func TestAddErrorListeners(t *testing.T) {
+	assert := assert.New(t)
+
+	log := logger.All()
+	defer log.Close()
+
+	sender := NewMockWebhookSender()
+	AddErrorListeners(log, sender, time.Hour, 0)
+	assert.True(log.HasListener(logger.Error, ListenerSlack))
+	assert.True(log.HasListener(logger.Fatal, ListenerSlack))
+
+	log.Trigger(logger.NewErrorEvent(logger.Error, fmt.Errorf("this is only a test")).WithLabel("service", "jobs"))
+
+	var message Message
+	select {
+	case message = <-sender:
+	case <-time.After(time.Second):
+		assert.FailNow("should have posted the error")
+	}
+	assert.Equal("error: this is only a test", message.Text)
+	assert.Len(message.Attachments, 1)
+	assert.Len(message.Attachments[0].Fields, 1)
+	assert.Equal("service", message.Attachments[0].Fields[0].Title)
+	assert.Equal("jobs", message.Attachments[0].Fields[0].Value)
+
+	log.Trigger(logger.NewErrorEvent(logger.Error, fmt.Errorf("this is only a test")))
+	log.Trigger(logger.NewErrorEvent(logger.Error, fmt.Errorf("this is a different test")))
+
+	select {
+	case message = <-sender:
+	case <-time.After(time.Second):
+		assert.FailNow("should have posted the different error")
+	}
+	assert.Equal("error: this is a different test", message.Text, "the repeated error should have been throttled")
+}
+
+func TestAddListenersUnset(t *testing.T) {
+	assert := assert.New(t)
+
+	log := logger.All()
+	defer log.Close()
+
+	AddListeners(log, &Config{})
+	assert.False(log.HasListener(logger.Error, ListenerSlack))
+}
+
+func TestErrorThrottler(t *testing.T) {
+	assert := assert.New(t)
+
+	throttler := &errorThrottler{
+		interval: time.Minute,
+		lastSent: map[string]time.Time{},
+	}
+	now := time.Date(2019, 01, 02, 03, 04, 05, 0, time.UTC)
+	assert.True(throttler.allow("foo", now))
+	assert.False(throttler.allow("foo", now.Add(30*time.Second)))
+	assert.True(throttler.allow("bar", now.Add(30*time.Second)))
+	assert.True(throttler.allow("foo", now.Add(time.Minute)))
+}
+
+func TestErrorThrottlerLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	throttler := &errorThrottler{
+		interval: time.Minute,
+		limit:    2,
+		lastSent: map[string]time.Time{},
+	}
+	now := time.Date(2019, 01, 02, 03, 04, 05, 0, time.UTC)
+	assert.True(throttler.allow("foo", now))
+	assert.True(throttler.allow("bar", now.Add(time.Second)))
+	assert.False(throttler.allow("baz", now.Add(2*time.Second)), "distinct errors past the limit should be dropped")
+	assert.True(throttler.allow("baz", now.Add(time.Minute)), "the first error should have left the interval")
+	assert.False(throttler.allow("buzz", now.Add(time.Minute)))
+}
@@ -901,6 +901,7 @@ func (a *App) renderAction(action Action) Handler {
 		var err error
 		var tf TraceFinisher
 
+		// responses are compressed by default; the `GZip` middleware turns this off for the routes it is used on.
 		var response ResponseWriter
 		if strings.Contains(r.Header.Get(HeaderAcceptEncoding), ContentEncodingGZIP) {
 			w.Header().Set(HeaderContentEncoding, ContentEncodingGZIP)
@@ -953,6 +954,10 @@ func (a *App) renderAction(action Action) Handler {
 		}
 
 		ctx.onRequestFinish()
+		// middleware can replace the response, e.g. to compress it, so close the response on the context as well.
+		if ctx.Response() != response {
+			a.logError(ctx.Response().Close())
+		}
 		a.logError(response.Close())
 
 		// effectively "request complete"
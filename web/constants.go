@@ -247,6 +247,9 @@ const (
 
 	// DefaultBufferPoolSize is the default buffer pool size.
 	DefaultViewBufferPoolSize = 256
+
+	// DefaultGZipMinSize is the default size, in bytes, under which responses are not compressed by the gzip middleware.
+	DefaultGZipMinSize = 1024
 )
 
 // DefaultHeaders are the default headers added by go-web.
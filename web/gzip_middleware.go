@@ -0,0 +1,49 @@
+package web
+
+import (
+	"strconv"
+	"strings"
+)
+
+// GZip returns a middleware that compresses responses with gzip for requests that accept it.
+// Responses smaller than `minSize` bytes, and responses with already compressed content types, are not compressed.
+// It replaces the compression the app applies to every response by default.
+// See `DefaultGZipMinSize` for a reasonable minimum size.
+func GZip(minSize int) Middleware {
+	return func(action Action) Action {
+		return func(r *Ctx) Result {
+			if typed, ok := r.Response().(*CompressedResponseWriter); ok {
+				// nothing has been written yet, so the default compression can be swapped out.
+				typed.Header().Set(HeaderContentEncoding, ContentEncodingIdentity)
+				r = r.WithResponse(NewRawResponseWriter(typed.InnerResponse()))
+			}
+			r.Response().Header().Add(HeaderVary, HeaderAcceptEncoding)
+			if !acceptsGZip(r.Request().Header.Get(HeaderAcceptEncoding)) {
+				return action(r)
+			}
+			return action(r.WithResponse(NewGZipResponseWriter(r.Response(), minSize)))
+		}
+	}
+}
+
+// acceptsGZip returns if an `Accept-Encoding` header value accepts gzip, i.e. lists `gzip` or `*` without a zero quality.
+func acceptsGZip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		pieces := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(pieces[0]))
+		if coding != ContentEncodingGZIP && coding != "*" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range pieces[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if parsed, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+		return quality > 0
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package web
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func gunzip(contents []byte) (string, error) {
+	reader, err := gzip.NewReader(strings.NewReader(string(contents)))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	uncompressed, err := ioutil.ReadAll(reader)
+	return string(uncompressed), err
+}
+
+func TestGZip(t *testing.T) {
+	assert := assert.New(t)
+
+	large := strings.Repeat("this is only a test. ", 100)
+	app := New()
+	app.WithDefaultMiddleware(GZip(DefaultGZipMinSize))
+	app.GET("/large", func(r *Ctx) Result {
+		return r.Text().Result(large)
+	})
+	app.GET("/small", func(r *Ctx) Result {
+		return r.Text().Result("ok")
+	})
+	app.GET("/json", func(r *Ctx) Result {
+		return JSON.Status(http.StatusNotFound, map[string]string{"message": large})
+	})
+	app.GET("/image", func(r *Ctx) Result {
+		return r.RawWithContentType("image/png", []byte(large))
+	})
+
+	res, err := app.Mock().WithHeader(HeaderAcceptEncoding, "deflate, gzip").Get("/large").Response()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, res.StatusCode)
+	assert.Equal(ContentEncodingGZIP, res.Header.Get(HeaderContentEncoding))
+	assert.Equal(HeaderAcceptEncoding, res.Header.Get(HeaderVary))
+	contents, err := ioutil.ReadAll(res.Body)
+	assert.Nil(err)
+	assert.True(len(contents) < len(large))
+	uncompressed, err := gunzip(contents)
+	assert.Nil(err)
+	assert.Equal(large, uncompressed)
+
+	res, err = app.Mock().WithHeader(HeaderAcceptEncoding, "gzip").Get("/json").Response()
+	assert.Nil(err)
+	assert.Equal(http.StatusNotFound, res.StatusCode, "the status code should be kept")
+	assert.Equal(ContentEncodingGZIP, res.Header.Get(HeaderContentEncoding))
+	assert.HasPrefix(res.Header.Get(HeaderContentType), ContentTypeApplicationJSON)
+	contents, err = ioutil.ReadAll(res.Body)
+	assert.Nil(err)
+	uncompressed, err = gunzip(contents)
+	assert.Nil(err)
+	assert.Contains(uncompressed, large)
+
+	contents, meta, err := app.Mock().WithHeader(HeaderAcceptEncoding, "gzip").Get("/small").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(ContentEncodingIdentity, meta.Headers.Get(HeaderContentEncoding), "small responses should not be compressed")
+	assert.Equal("ok", string(contents))
+
+	contents, meta, err = app.Mock().WithHeader(HeaderAcceptEncoding, "gzip").Get("/image").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(ContentEncodingIdentity, meta.Headers.Get(HeaderContentEncoding), "compressed content types should not be compressed")
+	assert.Equal(large, string(contents))
+
+	contents, meta, err = app.Mock().Get("/large").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(ContentEncodingIdentity, meta.Headers.Get(HeaderContentEncoding), "requests that don't accept gzip should not be compressed")
+	assert.Equal(HeaderAcceptEncoding, meta.Headers.Get(HeaderVary))
+	assert.Equal(large, string(contents))
+
+	contents, meta, err = app.Mock().WithHeader(HeaderAcceptEncoding, "gzip;q=0").Get("/large").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(ContentEncodingIdentity, meta.Headers.Get(HeaderContentEncoding))
+	assert.Equal(large, string(contents))
+}
+
+func TestGZipDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	app := New()
+	app.GET("/small", func(r *Ctx) Result {
+		return r.Text().Result("ok")
+	})
+
+	res, err := app.Mock().WithHeader(HeaderAcceptEncoding, "gzip").Get("/small").Response()
+	assert.Nil(err)
+	assert.Equal(ContentEncodingGZIP, res.Header.Get(HeaderContentEncoding), "apps should compress responses by default")
+	contents, err := ioutil.ReadAll(res.Body)
+	assert.Nil(err)
+	uncompressed, err := gunzip(contents)
+	assert.Nil(err)
+	assert.Equal("ok", uncompressed)
+
+	contents, meta, err := app.Mock().Get("/small").BytesWithMeta()
+	assert.Nil(err)
+	assert.Equal(ContentEncodingIdentity, meta.Headers.Get(HeaderContentEncoding))
+	assert.Equal("ok", string(contents))
+}
+
+func TestAcceptsGZip(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(acceptsGZip("gzip"))
+	assert.True(acceptsGZip("deflate, GZIP;q=0.5"))
+	assert.True(acceptsGZip("*"))
+	assert.False(acceptsGZip(""))
+	assert.False(acceptsGZip("deflate, br"))
+	assert.False(acceptsGZip("gzip;q=0"))
+	assert.False(acceptsGZip("gzip; q=0.0, deflate"))
+}
@@ -0,0 +1,175 @@
+package web
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+)
+
+// NewGZipResponseWriter returns a new response writer that compresses output written to a given response writer,
+// if it is at least `minSize` bytes and its content type isn't already compressed.
+func NewGZipResponseWriter(w ResponseWriter, minSize int) *GZipResponseWriter {
+	return &GZipResponseWriter{
+		innerResponse: w,
+		minSize:       minSize,
+	}
+}
+
+// GZipResponseWriter is a response writer that compresses output with gzip.
+// It buffers output, and the status code, until it has at least `minSize` bytes to decide if it should compress the response;
+// responses smaller than that, or with a compressed content type or existing content encoding, are written as is.
+type GZipResponseWriter struct {
+	innerResponse ResponseWriter
+	minSize       int
+	statusCode    int
+	contentLength int
+
+	decided    bool
+	buffer     bytes.Buffer
+	gzipWriter *gzip.Writer
+}
+
+// Header returns the headers for the response.
+func (gzw *GZipResponseWriter) Header() http.Header {
+	return gzw.innerResponse.Header()
+}
+
+// WriteHeader records a status code; it is written when the response is compressed or written as is.
+// Only the first status code is written.
+func (gzw *GZipResponseWriter) WriteHeader(code int) {
+	if gzw.statusCode != 0 {
+		return
+	}
+	gzw.statusCode = code
+	if gzw.decided {
+		gzw.innerResponse.WriteHeader(code)
+	}
+}
+
+// Write writes the bytes to the response, buffering them until the response is large enough to compress.
+func (gzw *GZipResponseWriter) Write(b []byte) (int, error) {
+	gzw.contentLength += len(b)
+	if !gzw.decided {
+		gzw.buffer.Write(b)
+		if gzw.buffer.Len() < gzw.minSize {
+			return len(b), nil
+		}
+		if err := gzw.decide(); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if gzw.gzipWriter != nil {
+		return gzw.gzipWriter.Write(b)
+	}
+	return gzw.innerResponse.Write(b)
+}
+
+// InnerResponse returns the backing http response.
+func (gzw *GZipResponseWriter) InnerResponse() http.ResponseWriter {
+	return gzw.innerResponse.InnerResponse()
+}
+
+// StatusCode returns the status code for the request.
+func (gzw *GZipResponseWriter) StatusCode() int {
+	if gzw.statusCode != 0 {
+		return gzw.statusCode
+	}
+	return gzw.innerResponse.StatusCode()
+}
+
+// ContentLength returns the uncompressed content length for the request.
+func (gzw *GZipResponseWriter) ContentLength() int {
+	return gzw.contentLength
+}
+
+// IsCompressed returns if the response is being compressed; it is false until enough output is written to decide.
+func (gzw *GZipResponseWriter) IsCompressed() bool {
+	return gzw.gzipWriter != nil
+}
+
+// Flush writes any buffered output, deciding if the response is compressed with what has been written so far.
+func (gzw *GZipResponseWriter) Flush() error {
+	if !gzw.decided {
+		return gzw.decide()
+	}
+	if gzw.gzipWriter != nil {
+		return gzw.gzipWriter.Flush()
+	}
+	return nil
+}
+
+// Close writes any buffered output, finishes the compressed stream, and closes the inner response.
+func (gzw *GZipResponseWriter) Close() error {
+	if !gzw.decided {
+		if err := gzw.decide(); err != nil {
+			return err
+		}
+	}
+	if gzw.gzipWriter != nil {
+		if err := gzw.gzipWriter.Close(); err != nil {
+			return err
+		}
+	}
+	return gzw.innerResponse.Close()
+}
+
+// decide decides if the response should be compressed, writes the status code and any buffered output.
+func (gzw *GZipResponseWriter) decide() error {
+	gzw.decided = true
+	if gzw.buffer.Len() > 0 && gzw.buffer.Len() >= gzw.minSize && gzw.shouldCompress() {
+		gzw.Header().Set(HeaderContentEncoding, ContentEncodingGZIP)
+		gzw.Header().Del(HeaderContentLength)
+		gzw.gzipWriter = gzip.NewWriter(gzw.innerResponse)
+	}
+	if gzw.statusCode != 0 {
+		gzw.innerResponse.WriteHeader(gzw.statusCode)
+	}
+	if gzw.buffer.Len() == 0 {
+		return nil
+	}
+	var err error
+	if gzw.gzipWriter != nil {
+		_, err = gzw.gzipWriter.Write(gzw.buffer.Bytes())
+	} else {
+		_, err = gzw.innerResponse.Write(gzw.buffer.Bytes())
+	}
+	gzw.buffer.Reset()
+	return err
+}
+
+// shouldCompress returns if the response headers allow compressing the response.
+func (gzw *GZipResponseWriter) shouldCompress() bool {
+	if encoding := gzw.Header().Get(HeaderContentEncoding); encoding != "" && encoding != ContentEncodingIdentity {
+		return false
+	}
+	return !isCompressedContentType(gzw.Header().Get(HeaderContentType))
+}
+
+// isCompressedContentType returns if a content type is already compressed, or is streamed and shouldn't be buffered.
+func isCompressedContentType(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch {
+	case mediaType == "image/svg+xml":
+		return false
+	case strings.HasPrefix(mediaType, "image/"),
+		strings.HasPrefix(mediaType, "video/"),
+		strings.HasPrefix(mediaType, "audio/"):
+		return true
+	}
+	switch mediaType {
+	case "application/gzip",
+		"application/x-gzip",
+		"application/zip",
+		"application/x-bzip2",
+		"application/x-7z-compressed",
+		"application/x-rar-compressed",
+		"application/zstd",
+		"font/woff",
+		"font/woff2",
+		"text/event-stream":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package web
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/blend/go-sdk/webutil"
+)
+
+func TestGZipResponseWriterBuffersUntilMinSize(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := bytes.NewBuffer(nil)
+	inner := NewRawResponseWriter(webutil.NewMockResponse(buf))
+	gzw := NewGZipResponseWriter(inner, 16)
+
+	gzw.WriteHeader(http.StatusCreated)
+	gzw.WriteHeader(http.StatusInternalServerError)
+	assert.Equal(http.StatusCreated, gzw.StatusCode(), "only the first status code should be kept")
+	assert.Zero(inner.StatusCode(), "the status code should not be written until the response is decided")
+
+	written, err := gzw.Write([]byte("hello"))
+	assert.Nil(err)
+	assert.Equal(5, written)
+	assert.Zero(buf.Len())
+
+	assert.Nil(gzw.Flush())
+	assert.False(gzw.IsCompressed())
+	assert.Equal(http.StatusCreated, inner.StatusCode())
+	assert.Equal("hello", buf.String())
+	assert.Empty(gzw.Header().Get(HeaderContentEncoding))
+	assert.Nil(gzw.Close())
+}
+
+func TestGZipResponseWriterCompresses(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := bytes.NewBuffer(nil)
+	gzw := NewGZipResponseWriter(NewRawResponseWriter(webutil.NewMockResponse(buf)), 4)
+	gzw.Header().Set(HeaderContentType, ContentTypeText)
+	gzw.Header().Set(HeaderContentLength, "11")
+
+	_, err := gzw.Write([]byte("hello "))
+	assert.Nil(err)
+	assert.True(gzw.IsCompressed())
+	_, err = gzw.Write([]byte("world"))
+	assert.Nil(err)
+	assert.Nil(gzw.Close())
+
+	assert.Equal(ContentEncodingGZIP, gzw.Header().Get(HeaderContentEncoding))
+	assert.Empty(gzw.Header().Get(HeaderContentLength), "the uncompressed content length should be removed")
+	assert.Equal(11, gzw.ContentLength())
+	uncompressed, err := gunzip(buf.Bytes())
+	assert.Nil(err)
+	assert.Equal("hello world", uncompressed)
+}
+
+func TestIsCompressedContentType(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(isCompressedContentType("image/png"))
+	assert.True(isCompressedContentType("application/zip"))
+	assert.True(isCompressedContentType("text/event-stream; charset=utf-8"))
+	assert.False(isCompressedContentType("image/svg+xml"))
+	assert.False(isCompressedContentType(ContentTypeApplicationJSON))
+	assert.False(isCompressedContentType(""))
+}